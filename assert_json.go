@@ -1,6 +1,14 @@
 package goutils
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
 	"testing"
 	"time"
 
@@ -8,9 +16,19 @@ import (
 	"github.com/tidwall/gjson"
 )
 
+// UpdateSnapshotsEnv is the environment variable that, when set to "true",
+// causes MatchSnapshot to overwrite existing snapshots.
+const UpdateSnapshotsEnv = "UPDATE_SNAPSHOTS"
+
+// SnapshotDir is the directory MatchSnapshot stores snapshots in, relative
+// to the package directory of the test.
+var SnapshotDir = filepath.Join("testdata", "snapshots")
+
 type AssertJSON struct {
 	T    *testing.T
 	Body []byte
+
+	prefix string
 }
 
 func NewAssertJSON(t *testing.T, body []byte) *AssertJSON {
@@ -19,36 +37,198 @@ func NewAssertJSON(t *testing.T, body []byte) *AssertJSON {
 	return &AssertJSON{T: t, Body: body}
 }
 
+// Scope returns an AssertJSON sharing the same T and Body that prefixes
+// every path with prefix, e.g. a.Scope("data.user").Equal("name", "John")
+// asserts "data.user.name".
+func (a *AssertJSON) Scope(prefix string) *AssertJSON {
+	return &AssertJSON{T: a.T, Body: a.Body, prefix: a.path(prefix)}
+}
+
+// path returns the full path including the prefix of the scope.
+func (a *AssertJSON) path(path string) string {
+	if a.prefix == "" {
+		return path
+	}
+
+	return a.prefix + "." + path
+}
+
+// body returns the JSON of the scope, or the entire body if not scoped.
+func (a *AssertJSON) body() []byte {
+	if a.prefix == "" {
+		return a.Body
+	}
+
+	return []byte(gjson.GetBytes(a.Body, a.prefix).Raw)
+}
+
+// Regexp asserts the value at path matches regular expression rx. Fails
+// when the path does not exist, see MatchExists.
 func (a *AssertJSON) Regexp(path string, rx interface{}, msgAndArgs ...interface{}) {
-	assert.Regexp(a.T, rx, gjson.GetBytes(a.Body, path).Value(), msgAndArgs...)
+	a.T.Helper()
+
+	a.MatchExists(path, rx, msgAndArgs...)
+}
+
+// MatchExists asserts the path exists and its value matches regular
+// expression rx, e.g. a.MatchExists("id", "^[0-9a-f-]{36}$"). The failure
+// message distinguishes a missing path from a value in the wrong format.
+func (a *AssertJSON) MatchExists(path string, rx interface{}, msgAndArgs ...interface{}) {
+	a.T.Helper()
+
+	value := gjson.GetBytes(a.Body, a.path(path))
+	if !value.Exists() {
+		assert.Fail(a.T, fmt.Sprintf("path %q is missing", a.path(path)), msgAndArgs...)
+
+		return
+	}
+
+	if value.Type == gjson.Null || !matchRegexp(rx, value.String()) {
+		assert.Fail(a.T, fmt.Sprintf("path %q has wrong format: %s does not match %v", a.path(path), value.Raw, rx), msgAndArgs...)
+	}
+}
+
+// matchRegexp reports whether s matches rx, which is either a *regexp.Regexp
+// or a string containing a regular expression like in assert.Regexp.
+func matchRegexp(rx interface{}, s string) bool {
+	r, ok := rx.(*regexp.Regexp)
+	if !ok {
+		r = regexp.MustCompile(fmt.Sprint(rx))
+	}
+
+	return r.MatchString(s)
 }
 
 func (a *AssertJSON) Equal(path string, expected interface{}, msgAndArgs ...interface{}) {
-	assert.Equal(a.T, expected, gjson.GetBytes(a.Body, path).Value(), msgAndArgs...)
+	assert.Equal(a.T, expected, gjson.GetBytes(a.Body, a.path(path)).Value(), msgAndArgs...)
 }
 
 func (a *AssertJSON) Raw(path string, expected interface{}, msgAndArgs ...interface{}) {
-	assert.Equal(a.T, expected, gjson.GetBytes(a.Body, path).Raw, msgAndArgs...)
+	assert.Equal(a.T, expected, gjson.GetBytes(a.Body, a.path(path)).Raw, msgAndArgs...)
 }
 
+// Len asserts the number of elements of the array at path. Note that a
+// missing path or a value that is not an array is treated as an array of
+// one or zero elements, e.g. Len(path, 0) passes for null. Use ArrayLen or
+// ObjectLen to assert the type as well.
 func (a *AssertJSON) Len(path string, length int, msgAndArgs ...interface{}) {
-	assert.Len(a.T, gjson.GetBytes(a.Body, path).Array(), length, msgAndArgs...)
+	assert.Len(a.T, gjson.GetBytes(a.Body, a.path(path)).Array(), length, msgAndArgs...)
+}
+
+// ArrayLen asserts the value at path is an array with length elements.
+func (a *AssertJSON) ArrayLen(path string, length int, msgAndArgs ...interface{}) {
+	a.T.Helper()
+
+	value := gjson.GetBytes(a.Body, a.path(path))
+	if !value.IsArray() {
+		assert.Fail(a.T, fmt.Sprintf("path %q is not an array: %s", a.path(path), value.Raw), msgAndArgs...)
+
+		return
+	}
+
+	assert.Len(a.T, value.Array(), length, msgAndArgs...)
+}
+
+// ObjectLen asserts the value at path is an object with length keys.
+func (a *AssertJSON) ObjectLen(path string, length int, msgAndArgs ...interface{}) {
+	a.T.Helper()
+
+	value := gjson.GetBytes(a.Body, a.path(path))
+	if !value.IsObject() {
+		assert.Fail(a.T, fmt.Sprintf("path %q is not an object: %s", a.path(path), value.Raw), msgAndArgs...)
+
+		return
+	}
+
+	keys := 0
+
+	value.ForEach(func(_, _ gjson.Result) bool {
+		keys++
+
+		return true
+	})
+
+	assert.Equal(a.T, length, keys, msgAndArgs...)
 }
 
 func (a *AssertJSON) Nil(path string, msgAndArgs ...interface{}) {
-	assert.Nil(a.T, gjson.GetBytes(a.Body, path).Value(), msgAndArgs...)
+	assert.Nil(a.T, gjson.GetBytes(a.Body, a.path(path)).Value(), msgAndArgs...)
 }
 
 func (a *AssertJSON) TimeBetween(path string, minDur time.Duration, maxDur time.Duration, msgAndArgs ...interface{}) {
-	timeUntil := time.Until(gjson.GetBytes(a.Body, path).Time())
+	timeUntil := time.Until(gjson.GetBytes(a.Body, a.path(path)).Time())
 	assert.GreaterOrEqual(a.T, timeUntil, minDur, msgAndArgs...)
 	assert.LessOrEqual(a.T, timeUntil, maxDur, msgAndArgs...)
 }
 
 func (a *AssertJSON) True(path string, msgAndArgs ...interface{}) {
-	assert.True(a.T, gjson.GetBytes(a.Body, path).Bool(), msgAndArgs...)
+	assert.True(a.T, gjson.GetBytes(a.Body, a.path(path)).Bool(), msgAndArgs...)
 }
 
 func (a *AssertJSON) False(path string, msgAndArgs ...interface{}) {
-	assert.False(a.T, gjson.GetBytes(a.Body, path).Bool(), msgAndArgs...)
+	assert.False(a.T, gjson.GetBytes(a.Body, a.path(path)).Bool(), msgAndArgs...)
+}
+
+// EqualStruct unmarshals the entire body, or the scoped object when
+// scoped, into a new value of the same type as expected and asserts both
+// are equal.
+func (a *AssertJSON) EqualStruct(expected interface{}, msgAndArgs ...interface{}) {
+	a.T.Helper()
+
+	if expected == nil {
+		assert.Fail(a.T, "expected value must not be nil", msgAndArgs...)
+
+		return
+	}
+
+	actual := reflect.New(reflect.TypeOf(expected))
+	if err := json.Unmarshal(a.body(), actual.Interface()); err != nil {
+		assert.Fail(a.T, fmt.Sprintf("unmarshalling JSON body: %s", err), msgAndArgs...)
+
+		return
+	}
+
+	assert.Equal(a.T, expected, actual.Elem().Interface(), msgAndArgs...)
+}
+
+// MatchSnapshot compares the pretty-printed body, or the scoped object
+// when scoped, with the snapshot stored in testdata/snapshots/<name>.json.
+// The snapshot is created when it does not exist yet, or overwritten when
+// UPDATE_SNAPSHOTS=true.
+func (a *AssertJSON) MatchSnapshot(name string, msgAndArgs ...interface{}) {
+	a.T.Helper()
+
+	var actual bytes.Buffer
+	if err := json.Indent(&actual, a.body(), "", "  "); err != nil {
+		assert.Fail(a.T, fmt.Sprintf("formatting JSON body: %s", err), msgAndArgs...)
+
+		return
+	}
+
+	actual.WriteString("\n")
+
+	file := filepath.Join(SnapshotDir, name+".json")
+
+	expected, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) || os.Getenv(UpdateSnapshotsEnv) == "true" {
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil { //nolint:gomnd
+			assert.Fail(a.T, fmt.Sprintf("creating snapshot directory: %s", err), msgAndArgs...)
+
+			return
+		}
+
+		if err := os.WriteFile(file, actual.Bytes(), 0o644); err != nil { //nolint:gomnd,gosec
+			assert.Fail(a.T, fmt.Sprintf("writing snapshot %s: %s", file, err), msgAndArgs...)
+		}
+
+		return
+	}
+
+	if err != nil {
+		assert.Fail(a.T, fmt.Sprintf("reading snapshot %s: %s", file, err), msgAndArgs...)
+
+		return
+	}
+
+	assert.Equal(a.T, string(expected), actual.String(), msgAndArgs...)
 }
@@ -0,0 +1,106 @@
+package goutils_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	utils "github.com/nielskrijger/goutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type assertJSONUser struct {
+	Name  string   `json:"name"`
+	Roles []string `json:"roles"`
+}
+
+// assertJSONFails reports whether fn fails an assertion on body.
+func assertJSONFails(body string, fn func(a *utils.AssertJSON)) bool {
+	ft := &testing.T{}
+	fn(utils.NewAssertJSON(ft, []byte(body)))
+
+	return ft.Failed()
+}
+
+func TestAssertJSON_EqualStruct(t *testing.T) {
+	a := utils.NewAssertJSON(t, []byte(`{"name":"John","roles":["admin","user"]}`))
+
+	a.EqualStruct(assertJSONUser{Name: "John", Roles: []string{"admin", "user"}})
+	a.EqualStruct(&assertJSONUser{Name: "John", Roles: []string{"admin", "user"}})
+	a.EqualStruct(map[string]interface{}{"name": "John", "roles": []interface{}{"admin", "user"}})
+}
+
+func TestAssertJSON_MatchSnapshot(t *testing.T) {
+	a := utils.NewAssertJSON(t, []byte(`{"name":"John","roles":["admin","user"]}`))
+
+	a.MatchSnapshot("assert_json_user")
+}
+
+func TestAssertJSON_MatchSnapshotCreate(t *testing.T) {
+	utils.SnapshotDir = t.TempDir()
+
+	defer func() { utils.SnapshotDir = filepath.Join("testdata", "snapshots") }()
+
+	a := utils.NewAssertJSON(t, []byte(`{"name":"John"}`))
+	a.MatchSnapshot("new")
+
+	b, err := os.ReadFile(filepath.Join(utils.SnapshotDir, "new.json"))
+	assert.Nil(t, err)
+	assert.Equal(t, "{\n  \"name\": \"John\"\n}\n", string(b))
+
+	// Compares against the snapshot created before
+	a.MatchSnapshot("new")
+}
+
+func TestAssertJSON_Scope(t *testing.T) {
+	a := utils.NewAssertJSON(t, []byte(`{"data":{"user":{"name":"John","roles":["admin"],"address":{"city":"Amsterdam"}}}}`))
+
+	user := a.Scope("data.user")
+	user.Equal("name", "John")
+	user.Len("roles", 1)
+	user.Nil("age")
+	user.Scope("address").Equal("city", "Amsterdam")
+	user.Scope("address").EqualStruct(map[string]string{"city": "Amsterdam"})
+
+	assert.Same(t, a.T, user.T)
+	a.Equal("data.user.name", "John")
+}
+
+func TestAssertJSON_MatchExists(t *testing.T) {
+	a := utils.NewAssertJSON(t, []byte(`{"id":"9b2e4c1a","count":42,"user":{"email":"john@example.com"}}`))
+
+	a.MatchExists("id", "^[0-9a-f]{8}$")
+	a.MatchExists("count", regexp.MustCompile(`^\d+$`))
+	a.Scope("user").MatchExists("email", "@example.com$")
+	a.Regexp("id", "^[0-9a-f]+$")
+}
+
+func TestAssertJSON_ArrayLen(t *testing.T) {
+	body := `{"roles":["admin","user"],"empty":[],"name":"John","user":{}}`
+	a := utils.NewAssertJSON(t, []byte(body))
+
+	a.ArrayLen("roles", 2)
+	a.ArrayLen("empty", 0)
+
+	// Len treats a scalar or missing path as an array, ArrayLen does not
+	a.Len("name", 1)
+	a.Len("missing", 0)
+	assert.True(t, assertJSONFails(body, func(a *utils.AssertJSON) { a.ArrayLen("user", 1) }))
+	assert.True(t, assertJSONFails(body, func(a *utils.AssertJSON) { a.ArrayLen("name", 1) }))
+	assert.True(t, assertJSONFails(body, func(a *utils.AssertJSON) { a.ArrayLen("missing", 0) }))
+	assert.True(t, assertJSONFails(body, func(a *utils.AssertJSON) { a.ArrayLen("roles", 1) }))
+}
+
+func TestAssertJSON_ObjectLen(t *testing.T) {
+	body := `{"user":{"name":"John","age":42},"empty":{},"roles":["admin"],"name":"John"}`
+	a := utils.NewAssertJSON(t, []byte(body))
+
+	a.ObjectLen("user", 2)
+	a.ObjectLen("empty", 0)
+
+	assert.True(t, assertJSONFails(body, func(a *utils.AssertJSON) { a.ObjectLen("roles", 1) }))
+	assert.True(t, assertJSONFails(body, func(a *utils.AssertJSON) { a.ObjectLen("name", 0) }))
+	assert.True(t, assertJSONFails(body, func(a *utils.AssertJSON) { a.ObjectLen("missing", 0) }))
+	assert.True(t, assertJSONFails(body, func(a *utils.AssertJSON) { a.ObjectLen("user", 1) }))
+}
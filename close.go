@@ -1,6 +1,7 @@
 package goutils
 
 import (
+	"context"
 	"io"
 
 	"github.com/rs/zerolog"
@@ -9,10 +10,95 @@ import (
 // Close is a simple utility used to log error messages riased during a deferred closing function.
 // For example:
 //
-//    defer utils.Close(myLog, f)
-//
+//	defer utils.Close(myLog, f)
 func Close(log zerolog.Logger, c io.Closer) {
-	if err := c.Close(); err != nil {
+	_ = CloseE(log, c)
+}
+
+// CloseE closes c and logs an error like Close does, but also returns the error
+// so a caller can decide whether to fail. For example:
+//
+//	if err := utils.CloseE(myLog, db); err != nil {
+//	    os.Exit(1)
+//	}
+func CloseE(log zerolog.Logger, c io.Closer) error {
+	err := c.Close()
+	if err != nil {
 		log.Error().Err(err).Msgf("error while closing: %s", err)
 	}
+
+	return err
+}
+
+// MultiClose closes all closers concurrently and logs each error like Close
+// does. Returns once all closers finished or ctx is done, whichever comes
+// first. Closers that did not finish in time are logged and ctx.Err() is
+// returned; otherwise the first close error is returned. For example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	err := utils.MultiClose(ctx, myLog, db, conn)
+func MultiClose(ctx context.Context, log zerolog.Logger, closers ...io.Closer) error {
+	type result struct {
+		index int
+		err   error
+	}
+
+	// buffered so closers finishing after the deadline do not block forever
+	results := make(chan result, len(closers))
+
+	for i, c := range closers {
+		go func(i int, c io.Closer) {
+			results <- result{index: i, err: c.Close()}
+		}(i, c)
+	}
+
+	var firstErr error
+
+	finished := make([]bool, len(closers))
+
+	handle := func(r result) {
+		finished[r.index] = true
+
+		if r.err != nil {
+			log.Error().Err(r.err).Msgf("error while closing: %s", r.err)
+
+			if firstErr == nil {
+				firstErr = r.err
+			}
+		}
+	}
+
+	for pending := len(closers); pending > 0; pending-- {
+		select {
+		case r := <-results:
+			handle(r)
+		case <-ctx.Done():
+			// results buffered before ctx was done still count as finished
+		drain:
+			for pending > 0 {
+				select {
+				case r := <-results:
+					handle(r)
+					pending--
+				default:
+					break drain
+				}
+			}
+
+			if pending == 0 {
+				return firstErr
+			}
+
+			for i, c := range closers {
+				if !finished[i] {
+					log.Error().Err(ctx.Err()).Msgf("closer %d (%T) did not finish closing", i, c)
+				}
+			}
+
+			return ctx.Err()
+		}
+	}
+
+	return firstErr
 }
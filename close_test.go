@@ -1,8 +1,10 @@
 package goutils_test
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	utils "github.com/nielskrijger/goutils"
 	"github.com/rs/zerolog"
@@ -19,6 +21,33 @@ func (c *closerMock) Close() error {
 	return c.err
 }
 
+// blockingCloserMock blocks closing until release is closed.
+type blockingCloserMock struct {
+	release chan struct{}
+}
+
+func (c *blockingCloserMock) Close() error {
+	<-c.release
+
+	return nil
+}
+
+// cancelWriter cancels ctx before writing the first log line, giving the
+// other closers time to finish while MultiClose is still logging.
+type cancelWriter struct {
+	utils.TestLogger
+	cancel context.CancelFunc
+}
+
+func (w *cancelWriter) Write(p []byte) (int, error) {
+	if w.Count() == 0 {
+		time.Sleep(10 * time.Millisecond)
+		w.cancel()
+	}
+
+	return w.TestLogger.Write(p)
+}
+
 func TestClose_LogError(t *testing.T) {
 	testLogger := &utils.TestLogger{}
 
@@ -34,3 +63,63 @@ func TestClose_NoLog(t *testing.T) {
 	assert.Equal(t, "error while closing: test error", testLogger.LastLine()["message"])
 	assert.Equal(t, "test error", testLogger.LastLine()["error"])
 }
+
+func TestCloseE_ReturnsError(t *testing.T) {
+	testLogger := &utils.TestLogger{}
+
+	err := utils.CloseE(zerolog.New(testLogger), &closerMock{err: errTest})
+	assert.ErrorIs(t, err, errTest)
+	assert.Equal(t, "error while closing: test error", testLogger.LastLine()["message"])
+	assert.Equal(t, "test error", testLogger.LastLine()["error"])
+}
+
+func TestCloseE_Success(t *testing.T) {
+	testLogger := &utils.TestLogger{}
+
+	assert.Nil(t, utils.CloseE(zerolog.New(testLogger), &closerMock{}))
+	assert.Equal(t, 0, testLogger.Count())
+}
+
+func TestMultiClose(t *testing.T) {
+	testLogger := &utils.TestLogger{}
+
+	err := utils.MultiClose(context.Background(), zerolog.New(testLogger),
+		&closerMock{}, &closerMock{err: errTest}, &closerMock{})
+	assert.ErrorIs(t, err, errTest)
+	assert.Equal(t, 1, testLogger.Count())
+	assert.Equal(t, "error while closing: test error", testLogger.LastLine()["message"])
+}
+
+func TestMultiClose_NoClosers(t *testing.T) {
+	assert.Nil(t, utils.MultiClose(context.Background(), zerolog.Nop()))
+}
+
+func TestMultiClose_Timeout(t *testing.T) {
+	testLogger := &utils.TestLogger{}
+	slow := &blockingCloserMock{release: make(chan struct{})}
+
+	defer close(slow.release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := utils.MultiClose(ctx, zerolog.New(testLogger), &closerMock{}, slow)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, testLogger.ContainsMessage("closer 1 (*goutils_test.blockingCloserMock) did not finish closing"))
+	assert.False(t, testLogger.ContainsMessage("closer 0"))
+}
+
+func TestMultiClose_DoneAfterFinished(t *testing.T) {
+	// ctx.Done() and the buffered results are picked at random, so repeat
+	for i := 0; i < 20; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		testLogger := &cancelWriter{cancel: cancel}
+
+		err := utils.MultiClose(ctx, zerolog.New(testLogger),
+			&closerMock{err: errTest}, &closerMock{}, &closerMock{}, &closerMock{})
+		assert.ErrorIs(t, err, errTest)
+		assert.Equal(t, 1, testLogger.Count())
+
+		cancel()
+	}
+}
@@ -10,11 +10,13 @@ require (
 	github.com/imdario/mergo v0.3.12 // indirect
 	github.com/mitchellh/copystructure v1.2.0 // indirect
 	github.com/mr-tron/base58 v1.2.0
+	github.com/rivo/uniseg v0.2.0
 	github.com/rs/zerolog v1.23.0
 	github.com/satori/go.uuid v1.2.0
 	github.com/stretchr/testify v1.7.0
 	github.com/tidwall/gjson v1.8.1
 	golang.org/x/text v0.3.6
 	google.golang.org/genproto v0.0.0-20210726200206-e7812ac95cc0
-	google.golang.org/grpc v1.39.0
+	google.golang.org/grpc v1.43.0
+	google.golang.org/protobuf v1.27.1
 )
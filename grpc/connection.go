@@ -1,8 +1,12 @@
 package grpc
 
 import (
+	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -23,8 +27,44 @@ type TLSConfig struct {
 	Enable bool `yaml:"enable"`
 }
 
-// NewGrpcConnection establishes a connection with a grpc service.
-func NewGrpcConnection(cfg *ServiceConfig) *grpc.ClientConn {
+// NewGrpcConnection establishes a connection with a grpc service. Additional
+// dial options such as WithRetry() are applied after the default options.
+//
+// The connection is established in the background; the returned connection
+// is idle until the first call is made. Use NewGrpcConnectionReady to wait
+// for the connection to be ready.
+func NewGrpcConnection(cfg *ServiceConfig, opts ...grpc.DialOption) *grpc.ClientConn {
+	// Connect to service
+	conn, err := grpc.Dial(cfg.Address, append(dialOptions(cfg), opts...)...)
+	if err != nil {
+		panic(fmt.Errorf("connecting to grpc service %s: %w", cfg.Address, err))
+	}
+
+	return conn
+}
+
+// NewGrpcConnectionReady establishes a connection with a grpc service and
+// blocks until the connection is ready. Returns an error when the connection
+// is not ready before the context is done.
+func NewGrpcConnectionReady(
+	ctx context.Context,
+	cfg *ServiceConfig,
+	opts ...grpc.DialOption,
+) (*grpc.ClientConn, error) {
+	dialOpts := dialOptions(cfg)
+	dialOpts = append(dialOpts, opts...)
+	dialOpts = append(dialOpts, grpc.WithBlock())
+
+	conn, err := grpc.DialContext(ctx, cfg.Address, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to grpc service %s: %w", cfg.Address, err)
+	}
+
+	return conn, nil
+}
+
+// dialOptions returns the dial options for given service configuration.
+func dialOptions(cfg *ServiceConfig) []grpc.DialOption {
 	opts := make([]grpc.DialOption, 0)
 
 	if cfg.TLS != nil && cfg.TLS.Enable {
@@ -35,11 +75,74 @@ func NewGrpcConnection(cfg *ServiceConfig) *grpc.ClientConn {
 		opts = append(opts, grpc.WithInsecure())
 	}
 
-	// Connect to service
-	conn, err := grpc.Dial(cfg.Address, opts...)
+	return opts
+}
+
+// WithRetry returns a dial option that retries calls failing with status
+// code Unavailable using an exponential backoff. The maxAttempts includes
+// the original call and must be at least 2; grpc caps it at 5. Panics if
+// maxAttempts is less than 2.
+//
+// Grpc does not support a timeout per attempt, the timeout of the entire
+// call including retries is therefore set to maxAttempts * perRetryTimeout.
+//
+// Retries are enabled by default, setting the environment variable
+// GRPC_GO_RETRY to "off" disables them.
+func WithRetry(maxAttempts int, perRetryTimeout time.Duration) grpc.DialOption {
+	if maxAttempts < 2 { //nolint:gomnd
+		panic(fmt.Sprintf("grpc retry requires maxAttempts of at least 2, got %d", maxAttempts))
+	}
+
+	return grpc.WithDefaultServiceConfig(retryServiceConfig(maxAttempts, perRetryTimeout))
+}
+
+type serviceConfig struct {
+	MethodConfig []methodConfig `json:"methodConfig"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	Timeout     string       `json:"timeout"`
+	RetryPolicy retryPolicy  `json:"retryPolicy"`
+}
+
+// methodName is left empty to match all services and methods.
+type methodName struct{}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// retryServiceConfig returns the JSON service config of WithRetry.
+func retryServiceConfig(maxAttempts int, perRetryTimeout time.Duration) string {
+	cfg := serviceConfig{
+		MethodConfig: []methodConfig{{
+			Name:    []methodName{{}},
+			Timeout: durationString(time.Duration(maxAttempts) * perRetryTimeout),
+			RetryPolicy: retryPolicy{
+				MaxAttempts:          maxAttempts,
+				InitialBackoff:       "0.1s",
+				MaxBackoff:           "1s",
+				BackoffMultiplier:    2, //nolint:gomnd
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		}},
+	}
+
+	b, err := json.Marshal(cfg)
 	if err != nil {
-		panic(fmt.Errorf("connecting to grpc service %s: %w", cfg.Address, err))
+		panic(fmt.Errorf("marshalling grpc service config: %w", err))
 	}
 
-	return conn
+	return string(b)
+}
+
+// durationString formats a duration as expected by the service config,
+// e.g. "1.5s".
+func durationString(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
 }
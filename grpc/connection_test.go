@@ -1,11 +1,20 @@
 package grpc_test
 
 import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/nielskrijger/goutils/grpc"
 	"github.com/stretchr/testify/assert"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestNewGrpcConnection_Success(t *testing.T) {
@@ -28,3 +37,128 @@ func TestNewGrpcConnection_WithTLSSuccess(t *testing.T) {
 	assert.Equal(t, "test:50051", conn.Target())
 	assert.Equal(t, connectivity.Idle, conn.GetState())
 }
+
+func TestNewGrpcConnectionReady_Success(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	server := grpclib.NewServer()
+	defer server.Stop()
+
+	go func() { _ = server.Serve(lis) }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.NewGrpcConnectionReady(ctx, &grpc.ServiceConfig{
+		Address: lis.Addr().String(),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, connectivity.Ready, conn.GetState())
+	assert.Nil(t, conn.Close())
+}
+
+func TestNewGrpcConnectionReady_Timeout(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	// Nothing is listening on the address once the listener is closed
+	address := lis.Addr().String()
+	assert.Nil(t, lis.Close())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	conn, err := grpc.NewGrpcConnectionReady(ctx, &grpc.ServiceConfig{
+		Address: address,
+	})
+
+	assert.Nil(t, conn)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "connecting to grpc service "+address)
+}
+
+func TestRetryServiceConfig(t *testing.T) {
+	cfg := grpc.RetryServiceConfig(3, 1500*time.Millisecond)
+
+	assert.True(t, json.Valid([]byte(cfg)))
+	assert.JSONEq(t, `{"methodConfig":[{`+
+		`"name":[{}],`+
+		`"timeout":"4.5s",`+
+		`"retryPolicy":{`+
+		`"maxAttempts":3,`+
+		`"initialBackoff":"0.1s",`+
+		`"maxBackoff":"1s",`+
+		`"backoffMultiplier":2,`+
+		`"retryableStatusCodes":["UNAVAILABLE"]}}]}`, cfg)
+}
+
+func TestNewGrpcConnection_WithRetry(t *testing.T) {
+	// Dial fails when the default service config is malformed
+	conn := grpc.NewGrpcConnection(&grpc.ServiceConfig{
+		Address: "test:50051",
+	}, grpc.WithRetry(3, time.Second))
+
+	assert.Equal(t, "test:50051", conn.Target())
+	assert.Nil(t, conn.Close())
+}
+
+func TestWithRetry_InvalidMaxAttempts(t *testing.T) {
+	assert.PanicsWithValue(t, "grpc retry requires maxAttempts of at least 2, got 1", func() {
+		grpc.WithRetry(1, time.Second)
+	})
+}
+
+// unavailableOnceServer starts a grpc server answering any method, the
+// first call fails with status code Unavailable. Returns the address of
+// the server and the number of calls received.
+func unavailableOnceServer(t *testing.T) (string, *int32) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	var calls int32
+
+	server := grpclib.NewServer(grpclib.UnknownServiceHandler(func(_ interface{}, stream grpclib.ServerStream) error {
+		if err := stream.RecvMsg(&emptypb.Empty{}); err != nil {
+			return err
+		}
+
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return status.Error(codes.Unavailable, "try again")
+		}
+
+		return stream.SendMsg(&emptypb.Empty{})
+	}))
+	t.Cleanup(server.Stop)
+
+	go func() { _ = server.Serve(lis) }()
+
+	return lis.Addr().String(), &calls
+}
+
+func TestWithRetry_RetriesUnavailable(t *testing.T) {
+	address, calls := unavailableOnceServer(t)
+
+	conn := grpc.NewGrpcConnection(&grpc.ServiceConfig{Address: address}, grpc.WithRetry(3, time.Second))
+	defer conn.Close()
+
+	err := conn.Invoke(context.Background(), "/test.Service/Method", &emptypb.Empty{}, &emptypb.Empty{})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestNewGrpcConnection_NoRetry(t *testing.T) {
+	address, calls := unavailableOnceServer(t)
+
+	conn := grpc.NewGrpcConnection(&grpc.ServiceConfig{Address: address})
+	defer conn.Close()
+
+	err := conn.Invoke(context.Background(), "/test.Service/Method", &emptypb.Empty{}, &emptypb.Empty{})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
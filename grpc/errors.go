@@ -4,6 +4,9 @@ package grpc
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/nielskrijger/goutils/validate"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -13,12 +16,44 @@ import (
 
 var InternalError = status.Error(codes.Internal, "something went wrong, please try again later")
 
+// ErrorOption configures how validation errors are mapped to a grpc error.
+type ErrorOption func(*errorOptions)
+
+type errorOptions struct {
+	domain string
+}
+
+// invalidFieldsReason is the reason of the ErrorInfo added by WithErrorInfo.
+const invalidFieldsReason = "INVALID_FIELDS"
+
+// WithErrorInfo adds a single ErrorInfo detail with reason "INVALID_FIELDS"
+// and the given domain, e.g. the service name "users.example.com". For
+// every field violation the metadata contains the name of the rule that
+// failed in UPPER_SNAKE_CASE as "violation_<i>" and its parameter, if any,
+// as "violation_<i>_param", where i is the index of the violation in the
+// BadRequest detail, e.g. "violation_1": "GTE" and "violation_1_param": "3".
+// Violations without a rule, e.g. added using AddFieldError, are omitted.
+//
+// Unlike the description, which may be overridden or translated, the
+// rule name is derived from the tag (e.g. "required" or "gte") and
+// therefore stable to use in client logic. Panics if domain is empty.
+func WithErrorInfo(domain string) ErrorOption {
+	if domain == "" {
+		panic("grpc WithErrorInfo requires a domain")
+	}
+
+	return func(o *errorOptions) {
+		o.domain = domain
+	}
+}
+
 // ValidationErrors takes the validation error output and returns an
 // InvalidArgument grpc error. The grpc description contains a summary,
-// error details are stored as FieldViolations.
+// error details are stored as FieldViolations. Use WithErrorInfo() to add
+// a machine-readable rule name for every field.
 //
 // Returns nil if len(errs) == 0.
-func ValidationErrors(err error) error {
+func ValidationErrors(err error, opts ...ErrorOption) error {
 	if err == nil {
 		return nil
 	}
@@ -33,28 +68,13 @@ func ValidationErrors(err error) error {
 		return nil
 	}
 
-	st := status.New(codes.InvalidArgument, errs.Error())
-	br := &errdetails.BadRequest{}
-
-	for _, fieldErr := range errs {
-		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
-			Field:       fieldErr.Field,
-			Description: fieldErr.Description,
-		})
-	}
-
-	st, err = st.WithDetails(br)
-	if err != nil {
-		return status.New(codes.Internal, fmt.Sprintf("failed creating invalid argument error: %s", err)).Err()
-	}
-
-	return st.Err()
+	return invalidArgument(errs.Error(), errs, opts)
 }
 
 // ValidationError takes a field error and returns an InvalidArgument grpc error.
 //
 // Returns nil if err is nil.
-func ValidationError(err error) error {
+func ValidationError(err error, opts ...ErrorOption) error {
 	if err == nil {
 		return nil
 	}
@@ -65,17 +85,86 @@ func ValidationError(err error) error {
 		return status.New(codes.Internal, fmt.Sprintf("unexpected error type: %s", err)).Err()
 	}
 
-	st := status.New(codes.InvalidArgument, fieldErr.Error())
+	return invalidArgument(fieldErr.Error(), validate.FieldErrors{fieldErr}, opts)
+}
+
+// ValidationResult takes a validation result and returns an InvalidArgument
+// grpc error, see ValidationErrors.
+//
+// Returns nil if result is nil or valid.
+func ValidationResult(result *validate.ValidationResult, opts ...ErrorOption) error {
+	if result == nil || result.IsValid() {
+		return nil
+	}
+
+	return invalidArgument(result.Errors.Error(), result.Errors, opts)
+}
+
+// invalidArgument returns an InvalidArgument grpc error with msg as
+// description and the field errors stored as FieldViolations.
+func invalidArgument(msg string, errs validate.FieldErrors, opts []ErrorOption) error {
+	o := &errorOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	br := &errdetails.BadRequest{}
-	br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
-		Field:       fieldErr.Field,
-		Description: fieldErr.Description,
-	})
 
-	st, err = st.WithDetails(br)
+	for _, fieldErr := range errs {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       fieldErr.Field,
+			Description: fieldErr.Description,
+		})
+	}
+
+	st, err := status.New(codes.InvalidArgument, msg).WithDetails(br)
 	if err != nil {
 		return status.New(codes.Internal, fmt.Sprintf("failed creating invalid argument error: %s", err)).Err()
 	}
 
+	if o.domain != "" {
+		st, err = st.WithDetails(errorInfo(o.domain, errs))
+		if err != nil {
+			return status.New(codes.Internal, fmt.Sprintf("failed creating invalid argument error: %s", err)).Err()
+		}
+	}
+
 	return st.Err()
 }
+
+// errorInfo returns the ErrorInfo detail of WithErrorInfo.
+func errorInfo(domain string, errs validate.FieldErrors) *errdetails.ErrorInfo {
+	metadata := make(map[string]string, len(errs))
+
+	for i, fieldErr := range errs {
+		if fieldErr.Tag == "" {
+			continue
+		}
+
+		key := "violation_" + strconv.Itoa(i)
+		metadata[key] = reasonName(fieldErr.Tag)
+
+		if fieldErr.Param != "" {
+			metadata[key+"_param"] = fieldErr.Param
+		}
+	}
+
+	return &errdetails.ErrorInfo{
+		Reason:   invalidFieldsReason,
+		Domain:   domain,
+		Metadata: metadata,
+	}
+}
+
+// reasonName returns a rule tag in UPPER_SNAKE_CASE, e.g. "aZ09_" as "AZ09".
+func reasonName(tag string) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+
+		return '_'
+	}, tag)
+
+	return strings.Trim(name, "_")
+}
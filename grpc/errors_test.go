@@ -106,3 +106,120 @@ func TestValidationError_Success(t *testing.T) {
 	assert.Equal(t, "A", details.FieldViolations[0].Field)
 	assert.Equal(t, "Message A", details.FieldViolations[0].Description)
 }
+
+func TestValidationErrors_WithErrorInfo(t *testing.T) {
+	err := grpc.ValidationErrors(validate.FieldErrors{
+		{Field: "A", Description: "Message A", Tag: "required"},
+		{Field: "B", Description: "Message B", Tag: "gte", Param: "3"},
+	}, grpc.WithErrorInfo("users.example.com"))
+
+	r := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, r.Code())
+	assert.Len(t, r.Details(), 2)
+
+	details, ok := r.Details()[0].(*errdetails.BadRequest)
+	assert.True(t, ok, "details type is invalid")
+	assert.Len(t, details.FieldViolations, 2)
+
+	info, ok := r.Details()[1].(*errdetails.ErrorInfo)
+	assert.True(t, ok, "details type is invalid")
+	assert.Equal(t, "INVALID_FIELDS", info.Reason)
+	assert.Equal(t, "users.example.com", info.Domain)
+	assert.Equal(t, map[string]string{
+		"violation_0":       "REQUIRED",
+		"violation_1":       "GTE",
+		"violation_1_param": "3",
+	}, info.Metadata)
+}
+
+func TestValidationError_WithErrorInfo(t *testing.T) {
+	err := grpc.ValidationError(validate.Field("", "Name", "required"), grpc.WithErrorInfo("users.example.com"))
+
+	r := status.Convert(err)
+	assert.Len(t, r.Details(), 2)
+
+	info, ok := r.Details()[1].(*errdetails.ErrorInfo)
+	assert.True(t, ok, "details type is invalid")
+	assert.Equal(t, "INVALID_FIELDS", info.Reason)
+	assert.Equal(t, map[string]string{"violation_0": "REQUIRED"}, info.Metadata)
+}
+
+func TestValidationResult_WithErrorInfo(t *testing.T) {
+	result := validate.NewResult()
+	result.AddFieldError("A", "Message A")
+	result.AddError(validate.Field("", "B", "required"))
+
+	r := status.Convert(grpc.ValidationResult(result, grpc.WithErrorInfo("users.example.com")))
+
+	// violations without a rule have no metadata
+	info, ok := r.Details()[1].(*errdetails.ErrorInfo)
+	assert.True(t, ok, "details type is invalid")
+	assert.Equal(t, map[string]string{"violation_1": "REQUIRED"}, info.Metadata)
+}
+
+func TestWithErrorInfo_EmptyDomain(t *testing.T) {
+	assert.PanicsWithValue(t, "grpc WithErrorInfo requires a domain", func() {
+		grpc.WithErrorInfo("")
+	})
+}
+
+func TestValidationErrors_WithoutTags(t *testing.T) {
+	err := grpc.ValidationErrors(validate.FieldErrors{
+		{Field: "A", Description: "Message A", Tag: "required"},
+	})
+
+	assert.Len(t, status.Convert(err).Details(), 1)
+}
+
+func TestValidationResult_FromResult(t *testing.T) {
+	result := validate.NewResult()
+	result.AddFieldError("A", "Message A")
+	result.AddFieldError("B", "Message B")
+
+	err := grpc.ValidationResult(result)
+
+	assert.NotNil(t, err)
+	r := status.Convert(err)
+	assert.Equal(t, "fields are invalid: A, B", r.Message())
+	assert.Equal(t, codes.InvalidArgument, r.Code())
+
+	details, ok := r.Details()[0].(*errdetails.BadRequest)
+	assert.True(t, ok, "details type is invalid")
+	assert.Len(t, details.FieldViolations, 2)
+	assert.Equal(t, "B", details.FieldViolations[1].Field)
+	assert.Equal(t, "Message B", details.FieldViolations[1].Description)
+}
+
+func TestValidationResult_FromValidResult(t *testing.T) {
+	assert.Nil(t, grpc.ValidationResult(validate.NewResult()))
+	assert.Nil(t, grpc.ValidationResult(nil))
+}
+
+type reasonStruct struct {
+	Name     string `validate:"required" msg:"Vul je naam in"`
+	Email    string `validate:"email"`
+	Age      int    `validate:"gte=18"`
+	Username string `validate:"aZ09_"`
+}
+
+func TestValidationErrors_StableReasons(t *testing.T) {
+	err := grpc.ValidationErrors(
+		validate.Struct(&reasonStruct{Email: "invalid", Age: 17, Username: "john-doe"}),
+		grpc.WithErrorInfo("users.example.com"),
+	)
+
+	r := status.Convert(err)
+	assert.Len(t, r.Details(), 2)
+
+	info, ok := r.Details()[1].(*errdetails.ErrorInfo)
+	assert.True(t, ok, "details type is invalid")
+
+	// Rule names are derived from the tags regardless of (custom) descriptions
+	assert.Equal(t, map[string]string{
+		"violation_0":       "REQUIRED",
+		"violation_1":       "EMAIL",
+		"violation_2":       "GTE",
+		"violation_2_param": "18",
+		"violation_3":       "AZ09",
+	}, info.Metadata)
+}
@@ -0,0 +1,3 @@
+package grpc
+
+var RetryServiceConfig = retryServiceConfig
@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"errors"
+
+	"github.com/nielskrijger/goutils/validate"
+	"google.golang.org/grpc"
+)
+
+// ValidationStreamInterceptor returns a stream server interceptor that
+// converts validate.FieldErrors and validate.FieldError returned by a
+// stream handler into an InvalidArgument grpc error, see ValidationErrors.
+// Other errors are returned as-is.
+func ValidationStreamInterceptor(opts ...ErrorOption) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return validationStatus(handler(srv, ss), opts)
+	}
+}
+
+// validationStatus converts a validation error into an InvalidArgument grpc
+// error and returns any other error unchanged.
+func validationStatus(err error, opts []ErrorOption) error {
+	var (
+		fieldErrs validate.FieldErrors
+		fieldErr  validate.FieldError
+	)
+
+	switch {
+	case errors.As(err, &fieldErrs):
+		return ValidationErrors(fieldErrs, opts...)
+	case errors.As(err, &fieldErr):
+		return ValidationError(fieldErr, opts...)
+	default:
+		return err
+	}
+}
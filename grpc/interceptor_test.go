@@ -0,0 +1,55 @@
+package grpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nielskrijger/goutils/grpc"
+	"github.com/nielskrijger/goutils/validate"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// runStream runs the validation stream interceptor with a fake handler
+// returning err.
+func runStream(err error, opts ...grpc.ErrorOption) error {
+	handler := func(srv interface{}, stream grpclib.ServerStream) error {
+		return err
+	}
+
+	return grpc.ValidationStreamInterceptor(opts...)(nil, nil, &grpclib.StreamServerInfo{}, handler)
+}
+
+func TestValidationStreamInterceptor_FieldErrors(t *testing.T) {
+	err := runStream(fmt.Errorf("receiving: %w", validate.FieldErrors{
+		{Field: "A", Description: "Message A"},
+		{Field: "B", Description: "Message B"},
+	}))
+
+	r := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, r.Code())
+
+	details, ok := r.Details()[0].(*errdetails.BadRequest)
+	assert.True(t, ok, "details type is invalid")
+	assert.Len(t, details.FieldViolations, 2)
+	assert.Equal(t, "B", details.FieldViolations[1].Field)
+}
+
+func TestValidationStreamInterceptor_FieldError(t *testing.T) {
+	err := runStream(validate.FieldError{Field: "A", Description: "Message A", Tag: "required"}, grpc.WithErrorInfo("users.example.com"))
+
+	r := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, r.Code())
+	assert.Len(t, r.Details(), 2)
+}
+
+func TestValidationStreamInterceptor_PassThrough(t *testing.T) {
+	assert.Nil(t, runStream(nil))
+	assert.Same(t, errRandom, runStream(errRandom))
+
+	notFound := status.Error(codes.NotFound, "not found")
+	assert.Equal(t, notFound, runStream(notFound))
+}
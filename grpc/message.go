@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/nielskrijger/goutils/validate"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidateMessage validates a generated protobuf message using v and
+// returns an InvalidArgument grpc error, see ValidationErrors. Uses
+// validate.DefaultValidator if v is nil.
+//
+// Field errors refer to the protobuf field names instead of the Go field
+// names, e.g. "user_name" instead of "UserName", so clients can relate the
+// FieldViolations to the fields of the request they sent. Nested messages
+// are reported with their full path, e.g. "home_address.postal_code".
+//
+// Returns nil if msg is valid.
+func ValidateMessage(msg proto.Message, v *validate.Validator, opts ...ErrorOption) error {
+	if v == nil {
+		v = validate.DefaultValidator
+	}
+
+	err := v.StructCtx(validate.FullErrorPathContext(context.Background()), msg)
+
+	var errs validate.FieldErrors
+	if !errors.As(err, &errs) {
+		return ValidationErrors(err, opts...)
+	}
+
+	result := make(validate.FieldErrors, 0, len(errs))
+
+	for _, fieldErr := range errs {
+		fieldErr.Field = protoFieldPath(reflect.TypeOf(msg), fieldErr.Field)
+		result = append(result, fieldErr)
+	}
+
+	return ValidationErrors(result, opts...)
+}
+
+// protoFieldPath replaces the Go field names in path by the protobuf field
+// names found in the "protobuf" struct tags of t. Parts of the path that
+// cannot be resolved, e.g. the fields of a oneof, are left as-is.
+func protoFieldPath(t reflect.Type, path string) string {
+	parts := strings.Split(path, ".")
+
+	for i, part := range parts {
+		name, suffix := part, ""
+		if idx := strings.Index(part, "["); idx >= 0 {
+			name, suffix = part[:idx], part[idx:]
+		}
+
+		t = elemType(t)
+		if t.Kind() != reflect.Struct {
+			break
+		}
+
+		sf, ok := t.FieldByName(name)
+		if !ok {
+			break
+		}
+
+		if protoName := protoTagName(sf.Tag.Get("protobuf")); protoName != "" {
+			parts[i] = protoName + suffix
+		}
+
+		t = sf.Type
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// elemType returns the type of the values held by t if t is a pointer,
+// slice, array or map.
+func elemType(t reflect.Type) reflect.Type {
+	for {
+		switch t.Kind() { //nolint:exhaustive
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+		default:
+			return t
+		}
+	}
+}
+
+// protoTagName returns the field name of a protobuf struct tag such as
+// "bytes,1,opt,name=user_name,json=userName,proto3".
+func protoTagName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,83 @@
+package grpc_test
+
+import (
+	"testing"
+
+	"github.com/nielskrijger/goutils/grpc"
+	"github.com/nielskrijger/goutils/validate"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// createUserRequest mimics a message generated by protoc-gen-go.
+type createUserRequest struct {
+	UserName    string            `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" validate:"required"`
+	HomeAddress *address          `protobuf:"bytes,2,opt,name=home_address,json=homeAddress,proto3"`
+	Addresses   []*address        `protobuf:"bytes,3,rep,name=other_addresses,json=otherAddresses,proto3"`
+	Labels      map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" validate:"lte=1"`
+}
+
+type address struct {
+	PostalCode string `protobuf:"bytes,1,opt,name=postal_code,json=postalCode,proto3" validate:"required"`
+}
+
+func (*createUserRequest) ProtoReflect() protoreflect.Message { return nil }
+
+func TestValidateMessage_Valid(t *testing.T) {
+	err := grpc.ValidateMessage(&createUserRequest{UserName: "john"}, nil)
+
+	assert.Nil(t, err)
+}
+
+func TestValidateMessage_ProtoFieldNames(t *testing.T) {
+	err := grpc.ValidateMessage(&createUserRequest{
+		HomeAddress: &address{},
+		Addresses:   []*address{{PostalCode: "1234AB"}, {}},
+		Labels:      map[string]string{"a": "1", "b": "2"},
+	}, nil)
+
+	r := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, r.Code())
+
+	details, ok := r.Details()[0].(*errdetails.BadRequest)
+	assert.True(t, ok, "details type is invalid")
+
+	fields := make([]string, 0, len(details.FieldViolations))
+	for _, violation := range details.FieldViolations {
+		fields = append(fields, violation.Field)
+	}
+
+	assert.Len(t, fields, 4)
+	assert.Contains(t, fields, "user_name")
+	assert.Contains(t, fields, "home_address.postal_code")
+	assert.Contains(t, fields, "other_addresses[1].postal_code")
+	assert.Contains(t, fields, "labels")
+	assert.Contains(t, r.Message(), "user_name")
+
+	for _, field := range fields {
+		assert.NotContains(t, field, "PostalCode")
+	}
+}
+
+func TestValidateMessage_Validator(t *testing.T) {
+	v := validate.NewValidator(validate.WithStandardRules())
+	msg := &createUserRequest{UserName: "john", Addresses: []*address{{}}}
+
+	details := status.Convert(grpc.ValidateMessage(msg, v)).Details()[0].(*errdetails.BadRequest)
+	assert.Equal(t, "other_addresses[0].postal_code", details.FieldViolations[0].Field)
+
+	// the validator passed is not changed
+	var fieldErrors validate.FieldErrors
+	assert.ErrorAs(t, v.Struct(msg), &fieldErrors)
+	assert.Equal(t, "PostalCode", fieldErrors[0].Field)
+
+	// later changes to the validator are used
+	v.AddRule(validate.ValidationRule{
+		Tag:     "required",
+		Checker: func(interface{}, string) bool { return true },
+	})
+	assert.Nil(t, grpc.ValidateMessage(msg, v))
+}
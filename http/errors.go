@@ -0,0 +1,62 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/nielskrijger/goutils/validate"
+)
+
+// InternalErrorMessage is the description returned for any error that is
+// not a validation error.
+const InternalErrorMessage = "something went wrong, please try again later"
+
+// WriteValidationError writes a 400 Bad Request response with the
+// validation errors as JSON body, e.g.:
+//
+//	{"errors":[{"field":"name","description":"name is required"}]}
+//
+// Both FieldErrors and a single FieldError are supported. Any other error
+// results in a 500 Internal Server Error with a generic message.
+//
+// Nothing is written if err is nil.
+func WriteValidationError(w http.ResponseWriter, err error) {
+	if err == nil {
+		return
+	}
+
+	var fieldErrors validate.FieldErrors
+
+	var fieldError validate.FieldError
+
+	switch {
+	case errors.As(err, &fieldErrors):
+		writeJSON(w, http.StatusBadRequest, fieldErrors)
+	case errors.As(err, &fieldError):
+		writeJSON(w, http.StatusBadRequest, validate.FieldErrors{fieldError})
+	default:
+		WriteInternalError(w)
+	}
+}
+
+// WriteInternalError writes a 500 Internal Server Error response with a
+// generic message as JSON body, e.g.:
+//
+//	{"error":"something went wrong, please try again later"}
+func WriteInternalError(w http.ResponseWriter) {
+	writeJSON(w, http.StatusInternalServerError, map[string]string{"error": InternalErrorMessage})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		WriteInternalError(w)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
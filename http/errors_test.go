@@ -0,0 +1,58 @@
+package http_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	goutilshttp "github.com/nielskrijger/goutils/http"
+	"github.com/nielskrijger/goutils/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+var errRandom = errors.New("random error")
+
+func TestWriteValidationError_Nil(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	goutilshttp.WriteValidationError(rec, nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
+
+func TestWriteValidationError_FieldErrors(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	goutilshttp.WriteValidationError(rec, validate.FieldErrors{
+		{Field: "A", Description: "Message A"},
+		{Field: "B", Description: "Message B"},
+	})
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"errors":[`+
+		`{"field":"A","description":"Message A"},`+
+		`{"field":"B","description":"Message B"}]}`, rec.Body.String())
+}
+
+func TestWriteValidationError_FieldError(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	goutilshttp.WriteValidationError(rec, validate.Field("", "Name", "required"))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"errors":[{"field":"Name","description":"Name is required"}]}`, rec.Body.String())
+}
+
+func TestWriteValidationError_InvalidError(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	goutilshttp.WriteValidationError(rec, errRandom)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"error":"something went wrong, please try again later"}`, rec.Body.String())
+}
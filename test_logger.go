@@ -2,9 +2,15 @@ package goutils
 
 import (
 	"encoding/json"
+	"fmt"
 	"strings"
+
+	"github.com/stretchr/testify/assert"
 )
 
+// errorLevels are the log levels AssertNoErrors fails on.
+var errorLevels = map[string]bool{"error": true, "fatal": true, "panic": true}
+
 type TestLogger struct {
 	out []byte
 }
@@ -15,9 +21,16 @@ func (log *TestLogger) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
-func (log *TestLogger) Lines() (result []map[string]interface{}) {
-	lines := strings.Split(strings.TrimSpace(string(log.out)), "\n")
-	for _, line := range lines {
+// Lines returns all logged JSON lines. Returns an empty slice if nothing
+// was logged.
+func (log *TestLogger) Lines() []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+
+	for _, line := range strings.Split(string(log.out), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
 		jsonMap := make(map[string]interface{})
 		_ = json.Unmarshal([]byte(line), &jsonMap)
 		result = append(result, jsonMap)
@@ -26,8 +39,87 @@ func (log *TestLogger) Lines() (result []map[string]interface{}) {
 	return result
 }
 
+// Count returns the number of logged lines, blank lines are ignored.
+func (log *TestLogger) Count() int {
+	return len(log.Lines())
+}
+
+// LastLine returns the last logged JSON line, or nil if nothing was logged.
 func (log *TestLogger) LastLine() (result map[string]interface{}) {
 	lines := log.Lines()
+	if len(lines) == 0 {
+		return nil
+	}
 
 	return lines[len(lines)-1]
 }
+
+// FindLine returns the first logged line for which predicate returns true.
+// Returns false if no line matched.
+func (log *TestLogger) FindLine(predicate func(map[string]interface{}) bool) (map[string]interface{}, bool) {
+	for _, line := range log.Lines() {
+		if predicate(line) {
+			return line, true
+		}
+	}
+
+	return nil, false
+}
+
+// ContainsMessage returns true if the "message" field of any logged line
+// contains substr.
+func (log *TestLogger) ContainsMessage(substr string) bool {
+	_, found := log.FindLine(func(line map[string]interface{}) bool {
+		msg, ok := line["message"].(string)
+
+		return ok && strings.Contains(msg, substr)
+	})
+
+	return found
+}
+
+// AssertLevel asserts that a line was logged with the given level, e.g.
+// "error".
+func (log *TestLogger) AssertLevel(t assert.TestingT, level string) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	_, found := log.FindLine(func(line map[string]interface{}) bool {
+		return line["level"] == level
+	})
+
+	return assert.True(t, found, "expected a line with level %q, got %v", level, log.Lines())
+}
+
+// AssertField asserts that a line was logged with field key set to value.
+// Non-string fields are compared by their formatted value, e.g. "42".
+func (log *TestLogger) AssertField(t assert.TestingT, key, value string) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	_, found := log.FindLine(func(line map[string]interface{}) bool {
+		v, ok := line[key]
+
+		return ok && fmt.Sprint(v) == value
+	})
+
+	return assert.True(t, found, "expected a line with %s=%q, got %v", key, value, log.Lines())
+}
+
+// AssertNoErrors asserts that no line was logged with level "error",
+// "fatal" or "panic".
+func (log *TestLogger) AssertNoErrors(t assert.TestingT) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+
+	line, found := log.FindLine(func(line map[string]interface{}) bool {
+		level, _ := line["level"].(string)
+
+		return errorLevels[level]
+	})
+
+	return assert.False(t, found, "expected no errors, got %v", line)
+}
@@ -0,0 +1,135 @@
+package goutils_test
+
+import (
+	"testing"
+
+	utils "github.com/nielskrijger/goutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockT records whether an assertion failed.
+type mockT struct {
+	failed bool
+}
+
+func (m *mockT) Errorf(string, ...interface{}) {
+	m.failed = true
+}
+
+func newTestLogger(lines ...string) *utils.TestLogger {
+	testLogger := &utils.TestLogger{}
+	for _, line := range lines {
+		_, _ = testLogger.Write([]byte(line + "\n"))
+	}
+
+	return testLogger
+}
+
+func TestTestLogger_FindLine(t *testing.T) {
+	testLogger := newTestLogger(
+		`{"level":"info","message":"starting server"}`,
+		`{"level":"error","message":"connection refused","error":"dial tcp"}`,
+		`{"level":"error","message":"retrying"}`,
+	)
+
+	line, found := testLogger.FindLine(func(line map[string]interface{}) bool {
+		return line["level"] == "error"
+	})
+	assert.True(t, found)
+	assert.Equal(t, "connection refused", line["message"])
+
+	line, found = testLogger.FindLine(func(line map[string]interface{}) bool {
+		return line["level"] == "debug"
+	})
+	assert.False(t, found)
+	assert.Nil(t, line)
+}
+
+func TestTestLogger_ContainsMessage(t *testing.T) {
+	testLogger := newTestLogger(
+		`{"level":"info","message":"starting server"}`,
+		`{"level":"error","message":"connection refused","error":"dial tcp"}`,
+	)
+
+	assert.True(t, testLogger.ContainsMessage("connection refused"))
+	assert.True(t, testLogger.ContainsMessage("server"))
+	assert.False(t, testLogger.ContainsMessage("dial tcp"))
+	assert.False(t, newTestLogger().ContainsMessage("server"))
+}
+
+func TestTestLogger_Count(t *testing.T) {
+	assert.Equal(t, 0, newTestLogger().Count())
+	assert.Equal(t, 1, newTestLogger(`{"message":"one"}`).Count())
+	assert.Equal(t, 2, newTestLogger(`{"message":"one"}`, `{"message":"two"}`, "", "  ").Count())
+}
+
+func TestTestLogger_Lines(t *testing.T) {
+	tests := []struct {
+		lines    []string
+		expected []map[string]interface{}
+		last     map[string]interface{}
+	}{
+		{nil, []map[string]interface{}{}, nil},
+		{[]string{"", " "}, []map[string]interface{}{}, nil},
+		{
+			[]string{`{"message":"one"}`},
+			[]map[string]interface{}{{"message": "one"}},
+			map[string]interface{}{"message": "one"},
+		},
+		{
+			[]string{`{"message":"one"}`, `{"message":"two"}`, `{"message":"three"}`},
+			[]map[string]interface{}{{"message": "one"}, {"message": "two"}, {"message": "three"}},
+			map[string]interface{}{"message": "three"},
+		},
+	}
+
+	for _, tt := range tests {
+		testLogger := newTestLogger(tt.lines...)
+
+		assert.Equal(t, tt.expected, testLogger.Lines())
+		assert.Equal(t, tt.last, testLogger.LastLine())
+	}
+}
+
+func TestTestLogger_AssertLevel(t *testing.T) {
+	testLogger := newTestLogger(
+		`{"level":"info","message":"starting server"}`,
+		`{"level":"error","message":"connection refused"}`,
+	)
+
+	assert.True(t, testLogger.AssertLevel(t, "error"))
+
+	mock := &mockT{}
+	assert.False(t, testLogger.AssertLevel(mock, "debug"))
+	assert.True(t, mock.failed)
+}
+
+func TestTestLogger_AssertField(t *testing.T) {
+	testLogger := newTestLogger(
+		`{"level":"info","message":"request handled","status":200}`,
+		`{"level":"error","message":"connection refused","error":"dial tcp"}`,
+	)
+
+	assert.True(t, testLogger.AssertField(t, "error", "dial tcp"))
+	assert.True(t, testLogger.AssertField(t, "status", "200"))
+
+	mock := &mockT{}
+	assert.False(t, testLogger.AssertField(mock, "error", "timeout"))
+	assert.True(t, mock.failed)
+
+	mock = &mockT{}
+	assert.False(t, testLogger.AssertField(mock, "user", ""))
+	assert.True(t, mock.failed)
+}
+
+func TestTestLogger_AssertNoErrors(t *testing.T) {
+	assert.True(t, newTestLogger(`{"level":"info","message":"starting server"}`).AssertNoErrors(t))
+	assert.True(t, newTestLogger().AssertNoErrors(t))
+
+	for _, level := range []string{"error", "fatal", "panic"} {
+		mock := &mockT{}
+		testLogger := newTestLogger(`{"level":"info"}`, `{"level":"`+level+`","message":"failed"}`)
+		assert.False(t, testLogger.AssertNoErrors(mock), level)
+		assert.True(t, mock.failed, level)
+	}
+}
@@ -37,3 +37,70 @@ func BenchmarkStruct_Invalid(b *testing.B) {
 		_ = validate.Struct(benchmarkUserInvalid)
 	}
 }
+
+const benchmarkText = "Hello 👨‍👩‍👧‍👦, how are you doing?"
+
+func BenchmarkField_RuneLength(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = validate.Field(benchmarkText, "Text", "lte=50")
+	}
+}
+
+var graphemeValidator = validate.NewValidator(validate.WithStandardRules(), validate.WithGraphemeLength())
+
+func BenchmarkField_GraphemeLength(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = graphemeValidator.Field(benchmarkText, "Text", "lte=50")
+	}
+}
+
+type benchmarkAddress struct {
+	Street  string `validate:"required,lte=100"`
+	City    string `validate:"required,lte=100"`
+	Country string `validate:"len=2"`
+}
+
+type benchmarkOrder struct {
+	ID       string `validate:"required"`
+	Quantity int    `validate:"gte=1"`
+	Address  benchmarkAddress
+	Billing  *benchmarkAddress
+}
+
+type benchmarkCustomer struct {
+	Name     string `validate:"required,name"`
+	Email    string `validate:"required,email"`
+	Address  benchmarkAddress
+	Shipping *benchmarkAddress
+	Orders   []benchmarkOrder
+	Payload  [64]int64
+}
+
+var benchmarkLargeStruct = func() *benchmarkCustomer {
+	address := benchmarkAddress{Street: "Main street 1", City: "Amsterdam", Country: "NL"}
+	customer := &benchmarkCustomer{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Address:  address,
+		Shipping: &address,
+	}
+
+	for i := 0; i < 10; i++ {
+		customer.Orders = append(customer.Orders, benchmarkOrder{
+			ID:       "order",
+			Quantity: 1,
+			Address:  address,
+			Billing:  &address,
+		})
+	}
+
+	return customer
+}()
+
+func BenchmarkStruct_LargeNested(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = validate.Struct(benchmarkLargeStruct)
+	}
+}
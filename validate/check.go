@@ -0,0 +1,235 @@
+package validate
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// TagError describes a misconfigured validation tag found by Check.
+type TagError struct {
+	// Field is the path of the field, e.g. "Address.City".
+	Field string
+
+	// Tag is the validation tag of the field, e.g. "required,gender".
+	Tag string
+
+	// Message describes the problem, e.g. "invalid type for gender tag".
+	Message string
+}
+
+// Error implements the Error interface.
+func (te TagError) Error() string {
+	return fmt.Sprintf("field %s with tag %q: %s", te.Field, te.Tag, te.Message)
+}
+
+// Unwrap returns ErrUnsupported.
+func (te TagError) Unwrap() error {
+	return ErrUnsupported
+}
+
+// TagErrors contains all misconfigured validation tags found by Check.
+type TagErrors []TagError
+
+// Error implements the Error interface.
+func (te TagErrors) Error() string {
+	msgs := make([]string, 0, len(te))
+	for _, err := range te {
+		msgs = append(msgs, err.Error())
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Check inspects the validation tags of a struct type and its nested
+// structs without validating any values. Returns TagErrors listing the
+// tags that refer to an unknown rule or that are applied to a field type
+// the rule does not support, e.g. "gender" on an int. Use Check in a test
+// or at startup to find misconfigured tags before they panic during
+// validation. Panics if value is not a struct or pointer to a struct.
+func Check(value interface{}) error {
+	return DefaultValidator.Check(value)
+}
+
+// Check inspects the validation tags of a struct type and its nested
+// structs without validating any values. Returns TagErrors listing the
+// tags that refer to an unknown rule or that are applied to a field type
+// the rule does not support, e.g. "gender" on an int. Use Check in a test
+// or at startup to find misconfigured tags before they panic during
+// validation. Panics if value is not a struct or pointer to a struct.
+//
+// The type of a field is checked against the SupportedKinds of its rules.
+// The rules are also run against the zero value of the field type, e.g. to
+// find invalid parameters, so Check can only report problems a rule
+// detects for zero values. Custom rules with CheckerCtx or CheckerStruct
+// are never run, these may e.g. query a database or expect a parent.
+func (mv *Validator) Check(value interface{}) error {
+	st := reflect.TypeOf(value)
+	for st != nil && st.Kind() == reflect.Ptr {
+		st = st.Elem()
+	}
+
+	if st == nil || st.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("validate.Check requires a struct, got %v", st))
+	}
+
+	errs := mv.checkStruct(st, "", map[reflect.Type]bool{})
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+// checkStruct checks the tags of all fields of a struct type. Types that
+// are being checked already are skipped to support recursive types.
+func (mv *Validator) checkStruct(st reflect.Type, path string, checking map[reflect.Type]bool) (errs TagErrors) {
+	if checking[st] {
+		return nil
+	}
+
+	checking[st] = true
+	defer delete(checking, st)
+
+	for i := 0; i < st.NumField(); i++ {
+		sf := st.Field(i)
+
+		// only public fields are validatable
+		if !unicode.IsUpper(rune(sf.Name[0])) {
+			continue
+		}
+
+		tag := sf.Tag.Get(mv.tagName)
+		if tag == "-" {
+			continue
+		}
+
+		field := sf.Name
+		if path != "" {
+			field = path + "." + sf.Name
+		}
+
+		if tag != "" {
+			if msg := mv.checkTag(sf.Type, tag); msg != "" {
+				errs = append(errs, TagError{Field: field, Tag: tag, Message: msg})
+			}
+		}
+
+		// fields of embedded structs are promoted to the parent struct
+		if sf.Anonymous {
+			field = path
+		}
+
+		errs = append(errs, mv.checkNested(sf.Type, field, checking)...)
+	}
+
+	return errs
+}
+
+// checkNested checks the structs held by a field of type t.
+func (mv *Validator) checkNested(t reflect.Type, path string, checking map[reflect.Type]bool) TagErrors {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Array, reflect.Slice:
+		return mv.checkNested(t.Elem(), path, checking)
+	case reflect.Map:
+		return append(mv.checkNested(t.Key(), path, checking), mv.checkNested(t.Elem(), path, checking)...)
+	case reflect.Struct:
+		return mv.checkStruct(t, path, checking)
+	default:
+		return nil
+	}
+}
+
+// checkTag checks all rules of tag against type t. Returns the message of
+// the first rule that does not support t or panicked, or an empty string.
+func (mv *Validator) checkTag(t reflect.Type, tag string) (msg string) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg = fmt.Sprint(r)
+		}
+	}()
+
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	// the value of an interface is unknown until validation
+	if t.Kind() == reflect.Interface {
+		return ""
+	}
+
+	if rest, ok := trimOmitEmpty(tag); ok {
+		if rest == "" {
+			return ""
+		}
+
+		tag = rest
+	}
+
+	tag, keyTags, valueTags, dive := splitDive(tag)
+	if !dive || tag != "" {
+		ctx := mv.ruleContext(context.Background())
+		v := probeValue(t)
+
+		for _, tg := range mv.mustParseTags(tag) {
+			if len(tg.Rule.SupportedKinds) > 0 && !supportsType(tg.Rule, t) {
+				return fmt.Sprintf("invalid type for %s tag: %s", tg.Rule.Tag, t.Kind())
+			}
+
+			// context-aware rules may e.g. query a database or expect a parent
+			if tg.Rule.CheckerStruct != nil || (tg.Rule.CheckerCtx != nil && !tg.Rule.settingsCtx) {
+				continue
+			}
+
+			// values that cannot be marshalled to text fail rather than panic
+			if val, err := textValue(tg.Rule, v); err == nil {
+				tg.Rule.check(ctx, val, tg.Param)
+			}
+		}
+	}
+
+	if !dive {
+		return ""
+	}
+
+	if t.Kind() != reflect.Map {
+		return "invalid type for dive tag"
+	}
+
+	if keyTags != "" {
+		if msg := mv.checkTag(t.Key(), keyTags); msg != "" {
+			return msg
+		}
+	}
+
+	if valueTags != "" {
+		return mv.checkTag(t.Elem(), valueTags)
+	}
+
+	return ""
+}
+
+// supportsType returns true if rule supports values of type t, either
+// directly or by their text form, see textValue.
+func supportsType(rule ValidationRule, t reflect.Type) bool {
+	if rule.supportsKind(t.Kind()) {
+		return true
+	}
+
+	return rule.supportsKind(reflect.String) && t.Implements(textMarshalerType)
+}
+
+// probeValue returns a value of type t to run rules against. Slices hold
+// one element so rules checking each element inspect the element type.
+func probeValue(t reflect.Type) interface{} {
+	if t.Kind() == reflect.Slice {
+		return reflect.MakeSlice(t, 1, 1).Interface()
+	}
+
+	return reflect.Zero(t).Interface()
+}
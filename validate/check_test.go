@@ -0,0 +1,133 @@
+package validate_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nielskrijger/goutils/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+type checkAddress struct {
+	City    string `validate:"required"`
+	Country int    `validate:"locale"`
+}
+
+type checkNode struct {
+	Name     string `validate:"required"`
+	Children []*checkNode
+}
+
+type checkStruct struct {
+	Name     string            `validate:"required,name"`
+	Gender   int               `validate:"required,gender"`
+	Emails   []int             `validate:"email"`
+	Tags     []string          `validate:"dive,slug"`
+	Links    map[string]string `validate:"dive,keys,slug,endkeys,url"`
+	Counts   map[string]int    `validate:"dive,keys,gte=1,endkeys,email"`
+	Unknown  string            `validate:"omitempty,unknown"`
+	Size     *float64          `validate:"divisibleby=2"`
+	Any      interface{}       `validate:"gender"`
+	Address  checkAddress
+	Shipping *checkAddress `validate:"required"`
+	Tree     checkNode
+	Ignored  bool `validate:"-"`
+}
+
+func TestCheck(t *testing.T) {
+	err := validate.Check(checkStruct{})
+
+	var tagErrors validate.TagErrors
+	assert.ErrorAs(t, err, &tagErrors)
+	assert.ErrorIs(t, tagErrors[0], validate.ErrUnsupported)
+	assert.Equal(t, validate.TagErrors{
+		{Field: "Gender", Tag: "required,gender", Message: "invalid type for gender tag: int"},
+		{Field: "Emails", Tag: "email", Message: "invalid type for email tag: int"},
+		{Field: "Tags", Tag: "dive,slug", Message: "invalid type for dive tag"},
+		{Field: "Counts", Tag: "dive,keys,gte=1,endkeys,email", Message: "invalid type for email tag: int"},
+		{Field: "Unknown", Tag: "omitempty,unknown", Message: `unknown validate tag "unknown"`},
+		{Field: "Size", Tag: "divisibleby=2", Message: "invalid type for divisibleby tag: float64"},
+		{Field: "Address.Country", Tag: "locale", Message: "invalid type for locale tag: int"},
+		{Field: "Shipping.Country", Tag: "locale", Message: "invalid type for locale tag: int"},
+	}, tagErrors)
+	assert.Contains(t, err.Error(), `field Gender with tag "required,gender": invalid type for gender tag: int; `)
+}
+
+func TestCheck_Valid(t *testing.T) {
+	assert.Nil(t, validate.Check(&checkNode{}))
+	assert.Nil(t, validate.Check(fakeUser{}))
+}
+
+func TestCheck_NotAStruct(t *testing.T) {
+	assert.PanicsWithValue(t, "validate.Check requires a struct, got string", func() {
+		_ = validate.Check("abc")
+	})
+}
+
+func TestCheck_InvalidParams(t *testing.T) {
+	type params struct {
+		Name      string    `validate:"gte=abc"`
+		Code      string    `validate:"len=abc"`
+		Birthdate string    `validate:"mindate=abc"`
+		Deadline  time.Time `validate:"maxdate=abc"`
+		Adult     string    `validate:"minage=abc"`
+		StartsAt  string    `validate:"after=abc"`
+		EndsAt    time.Time `validate:"before=abc"`
+		Gender    string    `validate:"gender"`
+	}
+
+	err := validate.Check(params{})
+
+	var tagErrors validate.TagErrors
+	assert.ErrorAs(t, err, &tagErrors)
+
+	fields := make([]string, 0, len(tagErrors))
+	for _, tagErr := range tagErrors {
+		fields = append(fields, tagErr.Field)
+	}
+
+	assert.Equal(t, []string{"Name", "Code", "Birthdate", "Deadline", "Adult", "StartsAt", "EndsAt"}, fields)
+	assert.Equal(t, `cannot cast "abc" to int`, tagErrors[0].Message)
+}
+
+func TestCheck_ContextAwareRules(t *testing.T) {
+	var calls int
+
+	v := validate.NewValidator(validate.WithStandardRules())
+	v.AddRule(validate.ValidationRule{
+		Tag: "existing",
+		CheckerCtx: func(ctx context.Context, v interface{}, _ string) bool {
+			calls++
+
+			return false
+		},
+		SupportedKinds: []reflect.Kind{reflect.String},
+	})
+	v.AddRule(validate.ValidationRule{
+		Tag: "afterstart",
+		CheckerStruct: func(parent interface{}, v interface{}, _ string) bool {
+			calls++
+
+			return parent.(*struct{ Start int }).Start < v.(int)
+		},
+	})
+
+	assert.Nil(t, v.Check(struct {
+		Name string `validate:"existing"`
+		End  int    `validate:"afterstart"`
+	}{}))
+	assert.Zero(t, calls)
+
+	// The kind is still checked
+	err := v.Check(struct {
+		Name int `validate:"existing"`
+	}{})
+	assert.EqualError(t, err, `field Name with tag "existing": invalid type for existing tag: int`)
+
+	err = v.Check(struct {
+		Count bool `validate:"gte=1"`
+	}{})
+	assert.EqualError(t, err, `field Count with tag "gte=1": invalid type for gte tag: bool`)
+}
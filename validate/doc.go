@@ -25,23 +25,236 @@
 		  number. For number types, it's a simple lesser-than test; for strings
 		  it tests the number of characters whereas for maps and slices it tests
 		  the number of items.
+		  For a time.Duration the number may be written as a duration, e.g.
+		  "gte=1s,lte=1h".
+		- len=2: tests whether a variable has an exact length. For strings it
+		  tests the number of characters whereas for maps and slices it tests
+		  the number of items.
 		- required: checks whether a variable is non-zero as defined by the
 		  golang spec. You're advised not to use this validation for booleans
 		  and numbers,
 	    - since golang defaults empty numbers to 0 and empty booleans to false.
+		  A zero time.Time is considered empty, other structs never are.
+		  To require a number or boolean use a pointer such as *int or *bool;
+		  a nil pointer fails whereas a pointer to 0 or false passes.
+		  A channel or function is empty when it is nil.
+		- notblank: same as required, except strings containing only
+		  whitespace are considered empty as well.
+		- mustbetrue: boolean that is true, e.g. to accept terms and conditions.
 		- name: string containing unicode letters -,.' and not start or end
-		  with a space.
+		  with a space. Use NameRule() to allow additional characters.
 		- az09_: string containing 0-9, A-Z, _ and not start with a _.
-		- gender: string either "male", "female" or "genderqueer".
+		- alphanumunicode: string containing only unicode letters and digits.
+		- slug: string containing a-z, 0-9 and single hyphens that do not
+		  start or end the string, e.g. my-post-title.
+		- resourcename: string starting with "mtx:" followed by a-z, 0-9, -, /
+		  and : separated parts, e.g. mtx:users:123. Use WithResourcePrefix()
+		  to change the prefix.
+		- resourcepattern: same as resourcename, also allowing * wildcards.
+		- gender: string either "male", "female" or "genderqueer". Use
+		  WithGenderValues() to accept a different set of values.
 		- isodate: a time.Time where hour, minute, second and millisecond are 0.
-		  If value is a string checks if date is in YYYY-MM-DD format.
+		  If value is a string checks if date is in YYYY-MM-DD format. Use
+		  WithDateLayout() to accept a different format in all date rules,
+		  or a Go time layout as parameter, e.g. "isodate=02-01-2006".
 		- zoneinfo: zoneinfo timestamp, e.g. Europe/Amsterdam.
 		- locale: space-separated string of BCP47 language tags.
+		- strictlocale: same as locale, but the tags must be known and in
+		  canonical form, e.g. "en-US" instead of "en_US" or "en-us".
 		- mindate=2006-01-02: time.Time with a minimum date. "now" will use
 		  today's date.
 		- maxdate=2006-01-02: time.Time with a maximum date "now" will use
 		  today's date.
-		- url: accepts any url the golang request uri accepts.
+		  Both accept date strings as well; dates are in the layout set by
+		  WithDateLayout(), e.g. "mindate=01-01-1900" for "02-01-2006".
+		  Today's date is determined in UTC; use WithClockLocation() to use
+		  a different time zone.
+		- minage=18: time.Time or YYYY-MM-DD string birthdate of someone who
+		  is at least the given number of years old today. Strings are in
+		  the layout set by WithDateLayout().
+		- after=2006-01-02T15:04:05Z: time.Time or RFC3339 string after the
+		  given RFC3339 timestamp. "now" will use the current time.
+		- before=2006-01-02T15:04:05Z: time.Time or RFC3339 string before the
+		  given RFC3339 timestamp. "now" will use the current time.
+		- datetime=2006-01-02T15:04:05Z07:00: string matching the Go time
+		  layout specified as parameter.
+		- timeofday: 24-hour time in HH:MM format. Use "timeofday=seconds" to
+		  require HH:MM:SS.
+		- duration: Go duration string such as "2h45m" or a time.Duration.
+		  Other integer types are not supported.
+		  Optionally bounded by a minimum and maximum, e.g. "duration=1s\,1h".
+		- cron: cron expression with 5 fields, or 6 fields starting with
+		  seconds, e.g. "0 9 * JAN-JUN MON-FRI". Supports values, ranges,
+		  lists, steps, named months and days and macros such as @daily.
+		- password=min8 upper lower digit special: string meeting all specified
+		  password requirements; minimum length, an uppercase letter, a
+		  lowercase letter, a digit and a special character respectively.
+		- startswith=ORD-: string starting with the given value.
+		- endswith=.pdf: string ending with the given value.
+		- contains=@: string containing the given value.
+		- eq=v1: string, number or boolean equal to the given value. Numbers
+		  are compared by value. Escape commas in the value, e.g. "eq=a\,b".
+		- ne=deleted: string, number or boolean not equal to the given value.
+		- divisibleby=4: integer divisible by the given number.
+		- positive: number greater than zero.
+		- negative: number less than zero. Never passes for unsigned numbers.
+		- nonnegative: number greater than or equal to zero.
+		- nonpositive: number less than or equal to zero.
+		- has=admin: array or slice containing an element equal to the given
+		  value. Numbers are compared by value.
+		- unique: array or slice without duplicate elements. For structs the
+		  field to compare can be specified, e.g. unique=ID.
+		- url: accepts any url the golang request uri accepts. Optionally
+		  restrict the allowed schemes, e.g. "url=https" or "url=http https".
+		  Add "nouserinfo" or "nofragment" to reject urls with credentials or
+		  a fragment, e.g. "url=https nouserinfo nofragment".
+		- relurl: relative url with an absolute path and without scheme or
+		  host, e.g. "/dashboard?tab=1". Protocol-relative urls such as
+		  "//evil.com" are rejected, e.g. to prevent open redirects.
+		- hostname: hostname as defined by RFC 1123, e.g. www.example.com.
+		- fqdn: fully qualified domain name ending with a top-level domain,
+		  e.g. example.com. A trailing dot is allowed.
+		- port: integer or numeric string between 1 and 65535.
+		- mac: MAC address in colon, hyphen or dotted notation.
+		- semver: semantic version as defined by https://semver.org, e.g.
+		  1.2.3, 1.0.0-alpha.1 or 1.2.3+build.5.
+		- hexcolor: hex color in #rgb, #rrggbb or #rrggbbaa format.
+		- base64: standard base64 encoded string, padding is required.
+		- base64url: URL-safe base64 encoded string, padding is not allowed.
+		- mimetype: well-formed media type such as "image/png". Optionally
+		  restricted to a list of media types, e.g. "mimetype=image/png image/jpeg".
+		  A subtype of "*" accepts any subtype, e.g. "mimetype=image/*".
+		- filename: single filename without path separators or control
+		  characters that is not "." or "..". Optionally restricted to a list
+		  of extensions, e.g. "filename=.pdf .png".
+		- filepath: relative slash-separated path that does not escape its
+		  base directory, e.g. "docs/a.pdf". Accepts the same extension list
+		  as filename.
+		- printascii: string containing only printable ASCII characters, i.e.
+		  0x20 (space) up to and including 0x7E (~).
+		- nocontrol: string without Unicode control characters such as
+		  newlines, tabs, NUL or DEL.
+		- lowercase: string without uppercase letters.
+		- uppercase: string without lowercase letters.
+		- ean: EAN-8, UPC-A or EAN-13 barcode with a valid check digit.
+
+	Struct fields are validated recursively, also when they are tagless.
+	Cyclic structures, e.g. a tree whose nodes point to their parent, are
+	supported; a struct that is being validated already is skipped when it
+	is reached again through a pointer.
+	A "required" tag on a pointer to a struct reports an error when the
+	pointer is nil; when it is not nil the fields of the struct are
+	validated as well.
+
+	Fields of embedded structs are promoted to the parent struct; with
+	WithFullErrorPath() their error path is not prefixed with the embedded
+	type name. Use FullErrorPathContext with StructCtx to report full error
+	paths for a single call instead.
+
+	The error message of a struct field can be overridden using the "msg"
+	struct tag, e.g. `validate:"required" msg:"Please enter your name"`.
+	To override the message of a single rule suffix the tag with an
+	underscore and the rule name, e.g. `msg_required:"..."`. A rule-specific
+	message takes precedence over the generic message. The name of the
+	message tag can be changed with WithMessageTagName().
+
+	All other error messages can be translated or replaced by configuring a
+	resolver using WithMessageResolver().
+
+	By default only the first failing rule of a field is reported. Configure
+	WithAllFieldErrors() to report all failing rules of a field instead.
+
+	A tag starting with "omitempty" skips all other rules when the value is
+	empty as defined by the "required" rule, e.g. "omitempty,gte=3,lte=10".
+	A non-nil pointer is not empty, so a *int pointing to 0 is validated
+	by the other rules.
+
+	The "dive" keyword applies all tags that follow to the values of a map
+	instead of the map itself. Tags enclosed by "keys" and "endkeys" directly
+	after "dive" are applied to the map keys, e.g.
+	"required,dive,keys,slug,endkeys,url" requires a map with slugs as keys
+	and URLs as values. Errors are reported as "field[key](key)" and
+	"field[key](value)".
+
+	By default the "gte", "lte" and "len" rules measure the length of a
+	string as-is in runes. Configure WithTrimStrings() to ignore leading and
+	trailing whitespace when measuring; the value itself is not modified.
+	Configure WithGraphemeLength() to count grapheme clusters instead, so
+	that e.g. an emoji made up of several runes counts as one character.
+
+	Values implementing encoding.TextMarshaler, such as a custom Email type,
+	are validated by their text form by rules that accept strings but not
+	the type of the value itself, e.g. "email", "url", "gte" or "lte".
+	Rules that accept any type such as "required", and rules that support
+	the type directly such as "isodate" for a time.Time, receive the value
+	as-is. A value that cannot be marshalled fails the rule.
+
+	Use FieldTags to pass each tag as a separate argument instead of a
+	comma-separated string, e.g.
+	validate.FieldTags(name, "Name", "required", "gte=3", "lte=25").
+
+	Use Var to validate a standalone value that has no field name, e.g.
+	validate.Var(email, "required,email").
+
+	NormalizeEmail validates an email address like the "email" rule and
+	returns it with whitespace trimmed and its domain lowercased, e.g. to
+	store a canonical form.
+
+	Use Structs to validate a slice of structs at once; errors are prefixed
+	with the index of the element, e.g. "[2].Name".
+
+	Use Prefix to combine the errors of separately validated values, e.g.
+	validate.Prefix("address", validate.Struct(addr)) reports
+	"address.City".
+
+	Use Map to validate the structs held by the values of a map, such as a
+	decoded JSON payload; errors are prefixed with the key of the value,
+	e.g. "[user](value).Name".
+
+	Custom rules can short-circuit the remaining tags of a field. Set
+	StopOnSuccess to skip the remaining tags when the rule passes, or
+	StopOnFailure to skip them without an error when the rule fails. The
+	"optional" rule is a StopOnFailure rule.
+
+	Rules receive the value a pointer points to. Set Presence to receive a
+	non-nil pointer itself instead, like the "required" rule does.
+
+	A failing custom rule without ErrorFunc that is not StopOnFailure reports
+	"<field> is invalid". Use SetDefaultMessage to change this message.
+
+	Rules accepting "now" use time.Now by default. Configure WithNowFunc()
+	to use a different clock, e.g. to freeze the time in tests.
+
+	Use Clone to customize a shared validator, e.g. to add a tenant-specific
+	alias per request, without modifying the original validator. Rules and
+	aliases can be disabled with RemoveRule and RemoveAlias.
+	Use WithStandardRulesExcept to leave out standard rules when constructing
+	a validator instead, e.g. WithStandardRulesExcept("resourcename").
+
+	Rules and Aliases return the registered rule tags and alias expansions,
+	e.g. to generate documentation of the available tags.
+
+	Use RunRule to test a single rule, e.g. a custom rule, without parsing
+	tags: valid, msg, err := v.RunRule("gte=3", "ab", "Name").
+
+	Rules that need request-scoped data can set a context-aware CheckerCtx
+	instead of a Checker; use StructCtx and FieldCtx to pass the context.
+
+	Rules comparing a field with other fields of its struct, e.g. an end
+	date that must be after the start date, can set a CheckerStruct that
+	receives the struct containing the field. The struct is nil when a
+	value is validated with Field.
+
+	Unknown tags and rules used with an unsupported type cause a panic. Use
+	StructSafe and FieldSafe to receive an error wrapping ErrUnsupported
+	instead.
+
+	Custom rules can declare the kinds of values they support with
+	SupportedKinds; other values panic before the rule is run.
+
+	Use Check in a test or at startup to find such misconfigured tags in a
+	struct type before they panic during validation, e.g.
+	validate.Check(User{}).
 
 	In addition the following tags are aliases:
 		- username: "az09_,gte=4,lte=20"
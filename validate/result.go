@@ -61,6 +61,15 @@ func (r *ValidationResult) AddError(err error) {
 	}
 }
 
+// AddFieldError adds a FieldError with given field and description to
+// the ValidationResult.
+func (r *ValidationResult) AddFieldError(field string, description string) {
+	r.Errors = append(r.Errors, FieldError{
+		Field:       field,
+		Description: description,
+	})
+}
+
 func (r *ValidationResult) AddErrors(err ...error) {
 	for _, err := range err {
 		r.AddError(err)
@@ -19,8 +19,8 @@ func TestValidationResult_Empty(t *testing.T) {
 }
 
 func TestValidationResult_Invalid(t *testing.T) {
-	err1 := validate.FieldError{"error 1", "description 1"}
-	err2 := validate.FieldError{"error 2", "description 2"}
+	err1 := validate.FieldError{Field: "error 1", Description: "description 1"}
+	err2 := validate.FieldError{Field: "error 2", Description: "description 2"}
 
 	res := validate.NewResult(err1, err2)
 
@@ -31,8 +31,8 @@ func TestValidationResult_Invalid(t *testing.T) {
 }
 
 func TestValidationResult_AddErrors(t *testing.T) {
-	err1 := validate.FieldError{"error 1", "description 1"}
-	err2 := validate.FieldError{"error 2", "description 2"}
+	err1 := validate.FieldError{Field: "error 1", Description: "description 1"}
+	err2 := validate.FieldError{Field: "error 2", Description: "description 2"}
 	err3 := validate.FieldErrors{err1, err2}
 	res := validate.NewResult()
 
@@ -45,3 +45,13 @@ func TestValidationResult_AddErrors(t *testing.T) {
 	assert.Equal(t, err1, res.Errors[2])
 	assert.Equal(t, err2, res.Errors[3])
 }
+
+func TestValidationResult_AddFieldError(t *testing.T) {
+	res := validate.NewResult()
+
+	res.AddFieldError("Name", "Name is already taken")
+
+	assert.False(t, res.IsValid())
+	assert.Equal(t, validate.FieldError{Field: "Name", Description: "Name is already taken"}, res.Errors[0])
+	assert.Equal(t, "field is invalid: Name", res.Err().Error())
+}
@@ -1,115 +1,483 @@
 package validate
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
+	"mime"
+	"net"
 	"net/url"
+	"path"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/text/language"
 )
 
+// Kinds of values supported by the standard rules.
+var (
+	stringKinds     = []reflect.Kind{reflect.String}
+	stringListKinds = []reflect.Kind{reflect.String, reflect.Slice, reflect.Array}
+	collectionKinds = []reflect.Kind{reflect.Slice, reflect.Array}
+	lengthKinds     = []reflect.Kind{reflect.String, reflect.Slice, reflect.Map, reflect.Array}
+	timeKinds       = []reflect.Kind{reflect.String, reflect.Struct, reflect.Ptr}
+	durationKinds   = []reflect.Kind{reflect.String, reflect.Slice, reflect.Array, reflect.Int64}
+	integerKinds    = []reflect.Kind{
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+	}
+	numberKinds  = concatKinds(integerKinds, []reflect.Kind{reflect.Float32, reflect.Float64})
+	sizeKinds    = concatKinds(lengthKinds, numberKinds)
+	literalKinds = concatKinds([]reflect.Kind{reflect.String, reflect.Bool}, numberKinds)
+	portKinds    = concatKinds(stringKinds, integerKinds)
+)
+
+// defaultResourcePrefix is the prefix of resource names and patterns
+// in the standard "resourcename" and "resourcepattern" rules.
+const defaultResourcePrefix = "mtx:"
+
+// isoDateLayout is the default layout of dates in the date rules.
+const isoDateLayout = "2006-01-02"
+
 var (
 	// InvalidTime can be set on a time.Time field to indicate parsing
 	// the timestamp failed due to invalid formatting.
 	InvalidTime = time.Unix(0, 0)
 
+	// layoutPlaceholders renders a Go time layout in error messages,
+	// e.g. "2006-01-02" as "YYYY-MM-DD".
+	layoutPlaceholders = strings.NewReplacer("2006", "YYYY", "01", "MM", "02", "DD")
+
 	validGenders          = []string{"male", "female", "genderqueer"}
 	regexpAz              = regexp.MustCompile(`^[a-z][a-z_]*$`)
 	regexpAZ09            = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_]*$`)
-	regexpName            = regexp.MustCompile(`^[\p{L},.'-][\p{L} ,.'-]*[\p{L},.'-]$`)
+	regexpName            = nameRegexp("")
+	regexpAlphaNumUnicode = regexp.MustCompile(`^[\p{L}\p{N}]+$`)
 	regexpEmail           = regexp.MustCompile("^(?:(?:(?:(?:[a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(?:\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|(?:(?:\\x22)(?:(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(?:\\x20|\\x09)+)?(?:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:\\(?:[\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(\\x20|\\x09)+)?(?:\\x22)))@(?:(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$") //nolint
-	regexpResourceName    = regexp.MustCompile("^mtx:[a-z0-9-/]+(:[a-z0-9-/]+)*$")
-	regexpResourcePattern = regexp.MustCompile("^mtx:[a-z0-9-*/]+(:[a-z0-9-*/]+)*$")
+	defaultResources      = newResourceMatcher(defaultResourcePrefix)
+	regexpSemver          = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`) //nolint:lll
+	regexpTimeOfDay       = regexp.MustCompile(`^(?:[01]\d|2[0-3]):[0-5]\d$`)
+	regexpTimeOfDaySec    = regexp.MustCompile(`^(?:[01]\d|2[0-3]):[0-5]\d:[0-5]\d$`)
+	regexpHostnameLabel   = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	regexpTLD             = regexp.MustCompile(`^(?:[a-zA-Z]{2,63}|xn--[a-zA-Z0-9-]{1,59})$`)
+	regexpSlug            = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	regexpHexColor        = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+
+	cronMonths = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6, //nolint:gomnd
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12, //nolint:gomnd
+	}
+	cronDays = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6, //nolint:gomnd
+	}
+	cronSeconds = cronField{min: 0, max: 59} //nolint:gomnd
+	cronFields  = []cronField{
+		{min: 0, max: 59},                                 // minute
+		{min: 0, max: 23},                                 // hour
+		{min: 1, max: 31, question: true},                 // day of month
+		{min: 1, max: 12, names: cronMonths},              // month
+		{min: 0, max: 7, names: cronDays, question: true}, // day of week, 0 and 7 are Sunday
+	}
+	cronMacros = map[string]bool{
+		"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+		"@daily": true, "@midnight": true, "@hourly": true,
+	}
 
 	StandardRules = []ValidationRule{
 		{
 			Tag:       "required",
 			Checker:   Required,
 			ErrorFunc: RequiredErr,
+			Presence:  true,
+		},
+		{
+			Tag:       "notblank",
+			Checker:   NotBlank,
+			ErrorFunc: NotBlankErr,
+		},
+		{
+			Tag:            "mustbetrue",
+			Checker:        MustBeTrue,
+			ErrorFunc:      MustBeTrueErr,
+			SupportedKinds: []reflect.Kind{reflect.Bool, reflect.Ptr, reflect.Invalid},
+		},
+		{
+			Tag:           "optional",
+			Checker:       Optional,
+			StopOnFailure: true,
+		},
+		{
+			Tag:            "gte",
+			Checker:        GTE,
+			CheckerCtx:     gteCtx,
+			settingsCtx:    true,
+			ErrorFunc:      GTEErr,
+			SupportedKinds: sizeKinds,
+		},
+		{
+			Tag:            "lte",
+			Checker:        LTE,
+			CheckerCtx:     lteCtx,
+			settingsCtx:    true,
+			ErrorFunc:      LTEErr,
+			SupportedKinds: sizeKinds,
+		},
+		{
+			Tag:            "len",
+			Checker:        Len,
+			CheckerCtx:     lenCtx,
+			settingsCtx:    true,
+			ErrorFunc:      LenErr,
+			SupportedKinds: lengthKinds,
+		},
+		{
+			Tag:            "gender",
+			Checker:        Gender,
+			CheckerCtx:     genderCtx,
+			settingsCtx:    true,
+			ErrorFunc:      GenderErr,
+			errorFuncCtx:   genderErrCtx,
+			SupportedKinds: stringKinds,
+		},
+		{
+			Tag:            "isodate",
+			Checker:        ISODate,
+			CheckerCtx:     isoDateCtx,
+			settingsCtx:    true,
+			ErrorFunc:      ISODateErr,
+			errorFuncCtx:   isoDateErrCtx,
+			SupportedKinds: timeKinds,
+		},
+		{
+			Tag:            "mindate",
+			Checker:        MinDate,
+			CheckerCtx:     minDateCtx,
+			settingsCtx:    true,
+			ErrorFunc:      MinDateErr,
+			errorFuncCtx:   minDateErrCtx,
+			SupportedKinds: timeKinds,
+		},
+		{
+			Tag:            "maxdate",
+			Checker:        MaxDate,
+			CheckerCtx:     maxDateCtx,
+			settingsCtx:    true,
+			ErrorFunc:      MaxDateErr,
+			errorFuncCtx:   maxDateErrCtx,
+			SupportedKinds: timeKinds,
+		},
+		{
+			Tag:            "minage",
+			Checker:        MinAge,
+			CheckerCtx:     minAgeCtx,
+			settingsCtx:    true,
+			ErrorFunc:      MinAgeErr,
+			SupportedKinds: timeKinds,
+		},
+		{
+			Tag:            "after",
+			Checker:        After,
+			CheckerCtx:     afterCtx,
+			settingsCtx:    true,
+			ErrorFunc:      AfterErr,
+			errorFuncCtx:   afterErrCtx,
+			SupportedKinds: timeKinds,
+		},
+		{
+			Tag:            "before",
+			Checker:        Before,
+			CheckerCtx:     beforeCtx,
+			settingsCtx:    true,
+			ErrorFunc:      BeforeErr,
+			errorFuncCtx:   beforeErrCtx,
+			SupportedKinds: timeKinds,
+		},
+		{
+			Tag:            "datetime",
+			Checker:        DateTime,
+			ErrorFunc:      DateTimeErr,
+			SupportedKinds: stringKinds,
+		},
+		{
+			Tag:            "timeofday",
+			Checker:        TimeOfDay,
+			ErrorFunc:      TimeOfDayErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "duration",
+			Checker:        Duration,
+			ErrorFunc:      DurationErr,
+			SupportedKinds: durationKinds,
+		},
+		{
+			Tag:            "cron",
+			Checker:        Cron,
+			ErrorFunc:      CronErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "mimetype",
+			Checker:        MimeType,
+			ErrorFunc:      MimeTypeErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "filename",
+			Checker:        FileName,
+			ErrorFunc:      FileNameErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "filepath",
+			Checker:        FilePath,
+			ErrorFunc:      FilePathErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "printascii",
+			Checker:        PrintASCII,
+			ErrorFunc:      PrintASCIIErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "nocontrol",
+			Checker:        NoControl,
+			ErrorFunc:      NoControlErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "lowercase",
+			Checker:        Lowercase,
+			ErrorFunc:      LowercaseErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "uppercase",
+			Checker:        Uppercase,
+			ErrorFunc:      UppercaseErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "eq",
+			Checker:        Eq,
+			ErrorFunc:      EqErr,
+			SupportedKinds: literalKinds,
+		},
+		{
+			Tag:            "ne",
+			Checker:        Ne,
+			ErrorFunc:      NeErr,
+			SupportedKinds: literalKinds,
+		},
+		{
+			Tag:            "divisibleby",
+			Checker:        DivisibleBy,
+			ErrorFunc:      DivisibleByErr,
+			SupportedKinds: integerKinds,
+		},
+		{
+			Tag:            "positive",
+			Checker:        Positive,
+			ErrorFunc:      PositiveErr,
+			SupportedKinds: numberKinds,
+		},
+		{
+			Tag:            "negative",
+			Checker:        Negative,
+			ErrorFunc:      NegativeErr,
+			SupportedKinds: numberKinds,
+		},
+		{
+			Tag:            "nonnegative",
+			Checker:        NonNegative,
+			ErrorFunc:      NonNegativeErr,
+			SupportedKinds: numberKinds,
+		},
+		{
+			Tag:            "nonpositive",
+			Checker:        NonPositive,
+			ErrorFunc:      NonPositiveErr,
+			SupportedKinds: numberKinds,
+		},
+		{
+			Tag:            "slug",
+			Checker:        Slug,
+			ErrorFunc:      SlugErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "name",
+			Checker:        Name,
+			ErrorFunc:      NameErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "az_",
+			Checker:        Az,
+			ErrorFunc:      AzErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "aZ09_",
+			Checker:        AZ09,
+			ErrorFunc:      AZ09Err,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "alphanumunicode",
+			Checker:        AlphaNumUnicode,
+			ErrorFunc:      AlphaNumUnicodeErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "zoneinfo",
+			Checker:        Zoneinfo,
+			ErrorFunc:      ZoneinfoErr,
+			SupportedKinds: stringKinds,
+		},
+		{
+			Tag:            "locale",
+			Checker:        Locale,
+			ErrorFunc:      LocaleErr,
+			SupportedKinds: stringKinds,
+		},
+		{
+			Tag:            "strictlocale",
+			Checker:        StrictLocale,
+			ErrorFunc:      StrictLocaleErr,
+			SupportedKinds: stringKinds,
+		},
+		{
+			Tag:            "url",
+			Checker:        URL,
+			ErrorFunc:      URLErr,
+			SupportedKinds: stringKinds,
+		},
+		{
+			Tag:            "relurl",
+			Checker:        RelURL,
+			ErrorFunc:      RelURLErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "hostname",
+			Checker:        Hostname,
+			ErrorFunc:      HostnameErr,
+			SupportedKinds: stringListKinds,
+		},
+		{
+			Tag:            "fqdn",
+			Checker:        FQDN,
+			ErrorFunc:      FQDNErr,
+			SupportedKinds: stringListKinds,
 		},
 		{
-			Tag:       "optional",
-			Checker:   Optional,
-			ErrorFunc: nil, // No error causes validation to stop
+			Tag:            "port",
+			Checker:        Port,
+			ErrorFunc:      PortErr,
+			SupportedKinds: portKinds,
 		},
 		{
-			Tag:       "gte",
-			Checker:   GTE,
-			ErrorFunc: GTEErr,
+			Tag:            "mac",
+			Checker:        MAC,
+			ErrorFunc:      MACErr,
+			SupportedKinds: stringListKinds,
 		},
 		{
-			Tag:       "lte",
-			Checker:   LTE,
-			ErrorFunc: LTEErr,
+			Tag:            "email",
+			Checker:        Email,
+			ErrorFunc:      EmailErr,
+			SupportedKinds: stringListKinds,
 		},
 		{
-			Tag:       "gender",
-			Checker:   Gender,
-			ErrorFunc: GenderErr,
+			Tag:            "resourcename",
+			Checker:        ResourceName,
+			CheckerCtx:     resourceNameCtx,
+			settingsCtx:    true,
+			ErrorFunc:      ResourceNameErr,
+			errorFuncCtx:   resourceNameErrCtx,
+			SupportedKinds: stringListKinds,
 		},
 		{
-			Tag:       "isodate",
-			Checker:   ISODate,
-			ErrorFunc: ISODateErr,
+			Tag:            "resourcepattern",
+			Checker:        ResourcePattern,
+			CheckerCtx:     resourcePatternCtx,
+			settingsCtx:    true,
+			ErrorFunc:      ResourcePatternErr,
+			errorFuncCtx:   resourcePatternErrCtx,
+			SupportedKinds: stringListKinds,
 		},
 		{
-			Tag:       "mindate",
-			Checker:   MinDate,
-			ErrorFunc: MinDateErr,
+			Tag:            "semver",
+			Checker:        Semver,
+			ErrorFunc:      SemverErr,
+			SupportedKinds: stringListKinds,
 		},
 		{
-			Tag:       "maxdate",
-			Checker:   MaxDate,
-			ErrorFunc: MaxDateErr,
+			Tag:            "hexcolor",
+			Checker:        HexColor,
+			ErrorFunc:      HexColorErr,
+			SupportedKinds: stringListKinds,
 		},
 		{
-			Tag:       "name",
-			Checker:   Name,
-			ErrorFunc: NameErr,
+			Tag:            "base64",
+			Checker:        Base64,
+			ErrorFunc:      Base64Err,
+			SupportedKinds: stringListKinds,
 		},
 		{
-			Tag:       "az_",
-			Checker:   Az,
-			ErrorFunc: AzErr,
+			Tag:            "base64url",
+			Checker:        Base64URL,
+			ErrorFunc:      Base64URLErr,
+			SupportedKinds: stringListKinds,
 		},
 		{
-			Tag:       "aZ09_",
-			Checker:   AZ09,
-			ErrorFunc: AZ09Err,
+			Tag:            "ean",
+			Checker:        EAN,
+			ErrorFunc:      EANErr,
+			SupportedKinds: stringListKinds,
 		},
 		{
-			Tag:       "zoneinfo",
-			Checker:   Zoneinfo,
-			ErrorFunc: ZoneinfoErr,
+			Tag:            "password",
+			Checker:        Password,
+			ErrorFunc:      PasswordErr,
+			SupportedKinds: stringKinds,
 		},
 		{
-			Tag:       "locale",
-			Checker:   Locale,
-			ErrorFunc: LocaleErr,
+			Tag:            "startswith",
+			Checker:        StartsWith,
+			ErrorFunc:      StartsWithErr,
+			SupportedKinds: stringKinds,
 		},
 		{
-			Tag:       "url",
-			Checker:   URL,
-			ErrorFunc: URLErr,
+			Tag:            "endswith",
+			Checker:        EndsWith,
+			ErrorFunc:      EndsWithErr,
+			SupportedKinds: stringKinds,
 		},
 		{
-			Tag:       "email",
-			Checker:   Email,
-			ErrorFunc: EmailErr,
+			Tag:            "contains",
+			Checker:        Contains,
+			ErrorFunc:      ContainsErr,
+			SupportedKinds: stringKinds,
 		},
 		{
-			Tag:       "resourcename",
-			Checker:   ResourceName,
-			ErrorFunc: ResourceNameErr,
+			Tag:            "has",
+			Checker:        Has,
+			ErrorFunc:      HasErr,
+			SupportedKinds: collectionKinds,
 		},
 		{
-			Tag:       "resourcepattern",
-			Checker:   ResourcePattern,
-			ErrorFunc: ResourcePatternErr,
+			Tag:            "unique",
+			Checker:        Unique,
+			ErrorFunc:      UniqueErr,
+			SupportedKinds: collectionKinds,
 		},
 	}
 
@@ -120,16 +488,24 @@ var (
 )
 
 // Required tests whether a variable is non-zero as defined by
-// the golang spec.
+// the golang spec. Structs are always non-zero, except for a zero
+// time.Time.
 //
 // You're advised not to use this validation for booleans and numbers,
 // since golang defaults empty numbers to 0 and empty booleans to false.
+// Use a pointer field such as *int instead; the validator passes a non-nil
+// pointer to this rule rather than the value it points to.
 func Required(v interface{}, _ string) bool { //nolint:cyclop
 	st := reflect.ValueOf(v)
 	switch st.Kind() {
 	case reflect.String:
 		return len(st.String()) != 0
-	case reflect.Ptr, reflect.Interface:
+	case reflect.Ptr, reflect.Interface, reflect.Chan, reflect.Func:
+		// a pointer to a zero timestamp is unset like the timestamp itself
+		if t, ok := v.(*time.Time); ok && t != nil {
+			return !t.IsZero()
+		}
+
 		return !st.IsNil()
 	case reflect.Slice, reflect.Map, reflect.Array:
 		return st.Len() != 0
@@ -144,7 +520,13 @@ func Required(v interface{}, _ string) bool { //nolint:cyclop
 	case reflect.Invalid:
 		return false // always invalid
 	case reflect.Struct:
-		return true // always valid since only nil pointers are empty
+		// a zero timestamp is unset, other structs are always valid since
+		// only nil pointers are empty
+		if t, ok := v.(time.Time); ok {
+			return !t.IsZero()
+		}
+
+		return true
 	default:
 		return false
 	}
@@ -154,24 +536,140 @@ func RequiredErr(field string, _ interface{}, _ Tag) string {
 	return fmt.Sprintf("%s is required", field)
 }
 
+// NotBlank tests whether a variable is non-zero like "required", except
+// strings consisting only of whitespace are considered empty as well.
+func NotBlank(v interface{}, _ string) bool {
+	st := reflect.ValueOf(v)
+	if st.Kind() == reflect.String {
+		return strings.TrimSpace(st.String()) != ""
+	}
+
+	return Required(v, "")
+}
+
+func NotBlankErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s cannot be blank", field)
+}
+
+// MustBeTrue tests whether a boolean is true, e.g. to require accepting
+// the terms and conditions. A nil *bool is not true.
+func MustBeTrue(v interface{}, param string) bool {
+	st := reflect.ValueOf(v)
+	switch st.Kind() {
+	case reflect.Bool:
+		return st.Bool()
+	case reflect.Ptr:
+		return !st.IsNil() && MustBeTrue(st.Elem().Interface(), param)
+	case reflect.Invalid:
+		return false
+	default:
+		panic("invalid type for mustbetrue tag")
+	}
+}
+
+func MustBeTrueErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must be accepted", field)
+}
+
 // Optional tests whether a variable is zero as defined by
 // the golang spec.
 func Optional(v interface{}, _ string) bool {
-	// It's the same as "required", causing an error when the value
-	// is empty. However in this case no error will be defined and
-	// the validation pipeline simply stops.
+	// It's the same as "required", failing when the value is empty.
+	// However the rule is marked StopOnFailure so no error is returned
+	// and the validation pipeline simply stops.
 	return Required(v, "")
 }
 
+// ruleSettingsKey is the context key of the settings of the validator
+// that configure the standard rules.
+type ruleSettingsKey struct{}
+
+// ruleSettings configure the standard rules, e.g. the values accepted by
+// the "gender" rule. The zero value uses the defaults.
+type ruleSettings struct {
+	genders   []string
+	resources *resourceMatcher
+	clock     clock
+	layout    string
+}
+
+// settingsFrom returns the rule settings in ctx, nil if there are none.
+func settingsFrom(ctx context.Context) *ruleSettings {
+	s, _ := ctx.Value(ruleSettingsKey{}).(*ruleSettings)
+
+	return s
+}
+
+// genderValues returns the values accepted by the "gender" rule.
+func (s *ruleSettings) genderValues() []string {
+	if s == nil || s.genders == nil {
+		return validGenders
+	}
+
+	return s.genders
+}
+
+// ruleClock returns the clock of the rules accepting "now".
+func (s *ruleSettings) ruleClock() clock {
+	if s == nil {
+		return clock{}
+	}
+
+	return s.clock
+}
+
+// dateLayout returns the layout of date strings in the date rules. The
+// param of the "isodate" rule takes precedence.
+func (s *ruleSettings) dateLayout(param string) string {
+	if param == "" && s != nil && s.layout != "" {
+		return s.layout
+	}
+
+	return dateLayout(param)
+}
+
+// resourceMatcher returns the matcher of the "resourcename" and
+// "resourcepattern" rules.
+func (s *ruleSettings) resourceMatcher() *resourceMatcher {
+	if s == nil || s.resources == nil {
+		return defaultResources
+	}
+
+	return s.resources
+}
+
+// lengthFuncKey is the context key of the function measuring the length
+// of strings in the "gte", "lte" and "len" rules.
+type lengthFuncKey struct{}
+
+// stringLength returns the number of characters in s. Characters are
+// runes unless another length function was set in ctx.
+func stringLength(ctx context.Context, s string) int64 {
+	if f, ok := ctx.Value(lengthFuncKey{}).(func(string) int); ok {
+		return int64(f(s))
+	}
+
+	return int64(utf8.RuneCountInString(s))
+}
+
 // GTE tests whether a variable value is larger or equal to a given
 // number. For number types, it's a simple greater-than test; for strings
 // it tests the number of characters whereas for maps and slices it tests
-// the number of items.
+// the number of items. For a time.Duration the param may be a duration,
+// e.g. "1s".
 func GTE(v interface{}, param string) bool {
+	return gteCtx(context.Background(), v, param)
+}
+
+func gteCtx(ctx context.Context, v interface{}, param string) bool {
+	if d, ok := v.(time.Duration); ok {
+		return d >= durationParam(param)
+	}
+
 	st := reflect.ValueOf(v)
 	switch st.Kind() {
 	case reflect.String:
-		return int64(len(st.String())) >= asInt(param)
+		return stringLength(ctx, st.String()) >= asInt(param)
 	case reflect.Slice, reflect.Map, reflect.Array:
 		return int64(st.Len()) >= asInt(param)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
@@ -186,6 +684,10 @@ func GTE(v interface{}, param string) bool {
 }
 
 func GTEErr(field string, v interface{}, t Tag) string {
+	if _, ok := v.(time.Duration); ok {
+		return fmt.Sprintf("%s must be at least %s", field, durationParam(t.Param))
+	}
+
 	st := reflect.ValueOf(v)
 	switch st.Kind() {
 	case reflect.Slice, reflect.Map, reflect.Array:
@@ -200,13 +702,22 @@ func GTEErr(field string, v interface{}, t Tag) string {
 // LTE tests whether a variable value is smaller or equal to a given
 // number. For number types, it's a simple lesser-than test; for strings
 // it tests the number of characters whereas for maps and slices it tests
-// the number of items.
+// the number of items. For a time.Duration the param may be a duration,
+// e.g. "1s".
 func LTE(v interface{}, param string) bool {
+	return lteCtx(context.Background(), v, param)
+}
+
+func lteCtx(ctx context.Context, v interface{}, param string) bool {
+	if d, ok := v.(time.Duration); ok {
+		return d <= durationParam(param)
+	}
+
 	st := reflect.ValueOf(v)
 
 	switch st.Kind() {
 	case reflect.String:
-		return int64(len(st.String())) <= asInt(param)
+		return stringLength(ctx, st.String()) <= asInt(param)
 	case reflect.Slice, reflect.Map, reflect.Array:
 		return int64(st.Len()) <= asInt(param)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
@@ -221,6 +732,10 @@ func LTE(v interface{}, param string) bool {
 }
 
 func LTEErr(field string, v interface{}, t Tag) string {
+	if _, ok := v.(time.Duration); ok {
+		return fmt.Sprintf("%s maximum value is %s", field, durationParam(t.Param))
+	}
+
 	st := reflect.ValueOf(v)
 
 	switch st.Kind() {
@@ -233,7 +748,56 @@ func LTEErr(field string, v interface{}, t Tag) string {
 	}
 }
 
+// Len tests whether a variable has an exact length. For strings it tests
+// the number of characters whereas for maps and slices it tests the number
+// of items.
+func Len(v interface{}, param string) bool {
+	return lenCtx(context.Background(), v, param)
+}
+
+func lenCtx(ctx context.Context, v interface{}, param string) bool {
+	st := reflect.ValueOf(v)
+
+	switch st.Kind() {
+	case reflect.String:
+		return stringLength(ctx, st.String()) == asInt(param)
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return int64(st.Len()) == asInt(param)
+	default:
+		panic("invalid type for len tag")
+	}
+}
+
+func LenErr(field string, v interface{}, t Tag) string {
+	st := reflect.ValueOf(v)
+
+	switch st.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return fmt.Sprintf("%s must contain exactly %s elements", field, t.Param)
+	default:
+		return fmt.Sprintf("%s must be exactly %s characters long", field, t.Param)
+	}
+}
+
+// Gender tests whether a string is either "male", "female" or
+// "genderqueer". Use WithGenderValues to accept a different set of values.
 func Gender(v interface{}, _ string) bool {
+	return isGender(v, validGenders)
+}
+
+func GenderErr(field string, _ interface{}, _ Tag) string {
+	return genderErr(field, validGenders)
+}
+
+func genderCtx(ctx context.Context, v interface{}, _ string) bool {
+	return isGender(v, settingsFrom(ctx).genderValues())
+}
+
+func genderErrCtx(ctx context.Context, field string, _ interface{}, _ Tag) string {
+	return genderErr(field, settingsFrom(ctx).genderValues())
+}
+
+func isGender(v interface{}, values []string) bool {
 	val, ok := v.(string)
 	if !ok {
 		panic("invalid type for gender tag")
@@ -243,7 +807,7 @@ func Gender(v interface{}, _ string) bool {
 		return true
 	}
 
-	for _, gender := range validGenders {
+	for _, gender := range values {
 		if gender == val {
 			return true
 		}
@@ -252,11 +816,30 @@ func Gender(v interface{}, _ string) bool {
 	return false
 }
 
-func GenderErr(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s must be either %s", field, strings.Join(validGenders, ", "))
+func genderErr(field string, values []string) string {
+	return fmt.Sprintf("%s must be either %s", field, strings.Join(values, ", "))
+}
+
+// ISODate tests whether a time.Time has no time component or whether a
+// string is a date in YYYY-MM-DD format. The param optionally specifies a
+// different Go time layout for strings, e.g. "isodate=02-01-2006".
+func ISODate(v interface{}, param string) bool {
+	return isoDate(v, dateLayout(param))
+}
+
+func ISODateErr(field string, _ interface{}, t Tag) string {
+	return isoDateErr(field, dateLayout(t.Param))
+}
+
+func isoDateCtx(ctx context.Context, v interface{}, param string) bool {
+	return isoDate(v, settingsFrom(ctx).dateLayout(param))
+}
+
+func isoDateErrCtx(ctx context.Context, field string, _ interface{}, t Tag) string {
+	return isoDateErr(field, settingsFrom(ctx).dateLayout(t.Param))
 }
 
-func ISODate(v interface{}, _ string) bool {
+func isoDate(v interface{}, layout string) bool {
 	st := reflect.ValueOf(v)
 	if st.Kind() == reflect.Ptr {
 		if st.IsNil() {
@@ -272,7 +855,7 @@ func ISODate(v interface{}, _ string) bool {
 			return true
 		}
 
-		t, err := time.Parse("2006-01-02", st.String())
+		t, err := time.Parse(layout, st.String())
 		if err != nil {
 			return false
 		}
@@ -299,52 +882,152 @@ func isWholeDate(t time.Time) bool {
 	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
 }
 
-func ISODateErr(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s is not a valid date (YYYY-MM-DD)", field)
+func isoDateErr(field string, layout string) string {
+	return fmt.Sprintf("%s is not a valid date (%s)", field, layoutPlaceholders.Replace(layout))
+}
+
+// MinDate tests whether a time.Time or YYYY-MM-DD string is on or after
+// the date specified as parameter, e.g. "mindate=2006-01-02". "now" will
+// use today's date.
+func MinDate(v interface{}, param string) bool {
+	return minDate(clock{}, isoDateLayout, v, param)
+}
+
+func MinDateErr(field string, _ interface{}, t Tag) string {
+	return minDateErr(clock{}, isoDateLayout, field, t)
+}
+
+// MaxDate tests whether a time.Time or date string is on or before the date
+// specified as parameter, e.g. "maxdate=2006-01-02". Accepts the same
+// parameters as MinDate.
+func MaxDate(v interface{}, param string) bool {
+	return maxDate(clock{}, isoDateLayout, v, param)
+}
+
+func MaxDateErr(field string, _ interface{}, t Tag) string {
+	return maxDateErr(clock{}, isoDateLayout, field, t)
+}
+
+func minDateCtx(ctx context.Context, v interface{}, param string) bool {
+	s := settingsFrom(ctx)
+
+	return minDate(s.ruleClock(), s.dateLayout(""), v, param)
+}
+
+func minDateErrCtx(ctx context.Context, field string, _ interface{}, t Tag) string {
+	s := settingsFrom(ctx)
+
+	return minDateErr(s.ruleClock(), s.dateLayout(""), field, t)
+}
+
+func maxDateCtx(ctx context.Context, v interface{}, param string) bool {
+	s := settingsFrom(ctx)
+
+	return maxDate(s.ruleClock(), s.dateLayout(""), v, param)
+}
+
+func maxDateErrCtx(ctx context.Context, field string, _ interface{}, t Tag) string {
+	s := settingsFrom(ctx)
+
+	return maxDateErr(s.ruleClock(), s.dateLayout(""), field, t)
+}
+
+func minDate(c clock, layout string, v interface{}, param string) bool {
+	t, bound, empty, ok := dateValue(c, layout, "mindate", v, param)
+	if empty {
+		return true
+	}
+
+	return ok && !t.Before(bound)
+}
+
+func minDateErr(c clock, layout string, field string, t Tag) string {
+	return fmt.Sprintf("%s minimum date is %s", field, c.dateString(t.Param, layout))
+}
+
+func maxDate(c clock, layout string, v interface{}, param string) bool {
+	t, bound, empty, ok := dateValue(c, layout, "maxdate", v, param)
+	if empty {
+		return true
+	}
+
+	return ok && !t.After(bound)
+}
+
+func maxDateErr(c clock, layout string, field string, t Tag) string {
+	return fmt.Sprintf("%s maximum date is %s", field, c.dateString(t.Param, layout))
 }
 
-func MinDate(v interface{}, param string) bool { //nolint:cyclop
+// dateValue returns the time of a time.Time or date string and the date
+// specified as parameter to compare it with, both strings in layout. A
+// date string is compared with today's date when the parameter is "now",
+// a time.Time with the current time. Returns empty true if there is no
+// value to validate and ok false if the value is not a valid date.
+func dateValue(c clock, layout string, tagName string, v interface{}, param string) (t, bound time.Time, empty, ok bool) {
 	st := reflect.ValueOf(v)
 	if st.Kind() == reflect.Ptr {
 		if st.IsNil() {
-			return true
+			return t, bound, true, false
 		}
 
 		st = st.Elem()
 	}
 
+	// the parameter is parsed first so that an invalid parameter panics
+	// regardless of the value
+	date := param
+	if date != "now" {
+		bound = parseDate(date, layout)
+	}
+
 	switch st.Kind() {
 	case reflect.String:
 		if st.String() == "" {
-			return true
+			return t, bound, true, false
 		}
 
-		t, err := time.Parse("2006-01-02", st.String())
+		parsed, err := time.Parse(layout, st.String())
 		if err != nil {
-			return false
+			return t, bound, false, false
 		}
 
-		minDate := parseDate(param)
+		if date == "now" {
+			return parsed, c.today(), false, true
+		}
 
-		return t.After(minDate) || t.Equal(minDate)
+		return parsed, bound, false, true
 	case reflect.Struct:
-		if t, ok := v.(time.Time); ok {
-			minDate := parseDate(param)
-
-			return t.After(minDate) || t.Equal(minDate)
+		t, ok = st.Interface().(time.Time)
+		if date == "now" {
+			return t, c.now(), false, ok
 		}
 
-		return false
+		return t, bound, false, ok
 	default:
-		panic("invalid type for mindate tag")
+		panic(fmt.Sprintf("invalid type for %s tag: %s", tagName, kindName(v)))
 	}
 }
 
-func MinDateErr(field string, _ interface{}, t Tag) string {
-	return fmt.Sprintf("%s minimum date is %s", field, nowToDateString(t.Param))
+// MinAge tests whether a birthdate as time.Time or YYYY-MM-DD string is at
+// least the number of years specified as parameter ago, e.g. "minage=18".
+// People born on February 29th reach their age on March 1st in non-leap years.
+func MinAge(v interface{}, param string) bool {
+	return minAge(clock{}, isoDateLayout, v, param)
+}
+
+func MinAgeErr(field string, _ interface{}, t Tag) string {
+	return fmt.Sprintf("%s must be at least %s years old", field, t.Param)
+}
+
+func minAgeCtx(ctx context.Context, v interface{}, param string) bool {
+	s := settingsFrom(ctx)
+
+	return minAge(s.ruleClock(), s.dateLayout(""), v, param)
 }
 
-func MaxDate(v interface{}, param string) bool { //nolint:cyclop
+func minAge(c clock, layout string, v interface{}, param string) bool {
+	years := asInt(param)
+
 	st := reflect.ValueOf(v)
 	if st.Kind() == reflect.Ptr {
 		if st.IsNil() {
@@ -360,169 +1043,1567 @@ func MaxDate(v interface{}, param string) bool { //nolint:cyclop
 			return true
 		}
 
-		t, err := time.Parse("2006-01-02", st.String())
+		t, err := time.Parse(layout, st.String())
 		if err != nil {
 			return false
 		}
 
-		maxDate := parseDate(param)
-
-		return t.Before(maxDate) || t.Equal(maxDate)
+		return int64(age(t, c.today())) >= years
 	case reflect.Struct:
-		if t, ok := v.(time.Time); ok {
-			maxDate := parseDate(param)
-
-			return t.Before(maxDate) || t.Equal(maxDate)
+		if t, ok := st.Interface().(time.Time); ok {
+			return int64(age(t, c.today())) >= years
 		}
 
 		return false
 	default:
-		panic("invalid type for maxdate tag")
+		panic("invalid type for minage tag")
 	}
 }
 
-func MaxDateErr(field string, _ interface{}, t Tag) string {
-	return fmt.Sprintf("%s maximum date is %s", field, nowToDateString(t.Param))
-}
+// age returns the number of whole years between birthdate and today.
+func age(birthdate time.Time, today time.Time) int {
+	years := today.Year() - birthdate.Year()
+	if today.Month() < birthdate.Month() ||
+		(today.Month() == birthdate.Month() && today.Day() < birthdate.Day()) {
+		years--
+	}
+
+	return years
+}
+
+// After tests whether a time.Time or RFC3339 string is after the RFC3339
+// timestamp specified as parameter, e.g. "after=2006-01-02T15:04:05Z".
+// "now" will use the current time.
+func After(v interface{}, param string) bool {
+	return after(clock{}, v, param)
+}
+
+func AfterErr(field string, _ interface{}, t Tag) string {
+	return afterErr(clock{}, field, t)
+}
+
+// Before tests whether a time.Time or RFC3339 string is before the RFC3339
+// timestamp specified as parameter, e.g. "before=2006-01-02T15:04:05Z".
+// "now" will use the current time.
+func Before(v interface{}, param string) bool {
+	return before(clock{}, v, param)
+}
+
+func BeforeErr(field string, _ interface{}, t Tag) string {
+	return beforeErr(clock{}, field, t)
+}
+
+func afterCtx(ctx context.Context, v interface{}, param string) bool {
+	return after(settingsFrom(ctx).ruleClock(), v, param)
+}
+
+func afterErrCtx(ctx context.Context, field string, _ interface{}, t Tag) string {
+	return afterErr(settingsFrom(ctx).ruleClock(), field, t)
+}
+
+func beforeCtx(ctx context.Context, v interface{}, param string) bool {
+	return before(settingsFrom(ctx).ruleClock(), v, param)
+}
+
+func beforeErrCtx(ctx context.Context, field string, _ interface{}, t Tag) string {
+	return beforeErr(settingsFrom(ctx).ruleClock(), field, t)
+}
+
+func after(c clock, v interface{}, param string) bool {
+	bound := c.parseTimestamp(param)
+
+	t, empty, ok := timestampValue("after", v)
+	if empty {
+		return true
+	}
+
+	return ok && t.After(bound)
+}
+
+func afterErr(c clock, field string, t Tag) string {
+	return fmt.Sprintf("%s must be after %s", field, c.timestampString(t.Param))
+}
+
+func before(c clock, v interface{}, param string) bool {
+	bound := c.parseTimestamp(param)
+
+	t, empty, ok := timestampValue("before", v)
+	if empty {
+		return true
+	}
+
+	return ok && t.Before(bound)
+}
+
+func beforeErr(c clock, field string, t Tag) string {
+	return fmt.Sprintf("%s must be before %s", field, c.timestampString(t.Param))
+}
+
+// timestampValue returns the time of a time.Time or RFC3339 string. Returns
+// empty true if there is no value to validate and ok false if the value is
+// not a valid timestamp.
+func timestampValue(tagName string, v interface{}) (t time.Time, empty bool, ok bool) {
+	st := reflect.ValueOf(v)
+	if st.Kind() == reflect.Ptr {
+		if st.IsNil() {
+			return t, true, false
+		}
+
+		st = st.Elem()
+	}
+
+	switch st.Kind() {
+	case reflect.String:
+		if st.String() == "" {
+			return t, true, false
+		}
+
+		parsed, err := time.Parse(time.RFC3339, st.String())
+
+		return parsed, false, err == nil
+	case reflect.Struct:
+		t, ok = st.Interface().(time.Time)
+
+		return t, false, ok
+	default:
+		panic(fmt.Sprintf("invalid type for %s tag: %s", tagName, kindName(v)))
+	}
+}
+
+// DateTime tests whether a string matches the Go time layout specified
+// by param, e.g. "datetime=2006-01-02T15:04:05Z07:00".
+func DateTime(v interface{}, param string) bool {
+	val, ok := v.(string)
+	if !ok {
+		panic("invalid type for datetime tag")
+	}
+
+	if val == "" {
+		return true
+	}
+
+	_, err := time.Parse(param, val)
+
+	return err == nil
+}
+
+func DateTimeErr(field string, _ interface{}, t Tag) string {
+	return fmt.Sprintf("%s is not a valid date time (%s)", field, t.Param)
+}
+
+// TimeOfDay tests whether a string is a 24-hour time in HH:MM format. Use
+// "timeofday=seconds" to require HH:MM:SS instead.
+func TimeOfDay(v interface{}, param string) bool {
+	if param == "seconds" {
+		return RegexChecker("timeofday", regexpTimeOfDaySec, v)
+	}
+
+	return RegexChecker("timeofday", regexpTimeOfDay, v)
+}
+
+func TimeOfDayErr(field string, _ interface{}, t Tag) string {
+	if t.Param == "seconds" {
+		return fmt.Sprintf("%s is not a valid time (HH:MM:SS)", field)
+	}
+
+	return fmt.Sprintf("%s is not a valid time (HH:MM)", field)
+}
+
+// Duration tests whether a string is a valid Go duration, e.g. "2h45m".
+// Fields of type time.Duration are accepted too, as are slices and arrays
+// of either. The optional param
+// specifies the minimum and maximum duration separated by an escaped
+// comma, e.g. "duration=1s\,1h". Either bound may be left empty.
+func Duration(v interface{}, param string) bool {
+	minDur, maxDur := durationBounds(param)
+
+	return isDuration(v, func(d time.Duration) bool {
+		return (minDur == nil || d >= *minDur) && (maxDur == nil || d <= *maxDur)
+	})
+}
+
+// isDuration tests whether v is a time.Duration or duration string within
+// range, or a slice or array of these. Panics for other types.
+func isDuration(v interface{}, inRange func(time.Duration) bool) bool {
+	if d, ok := v.(time.Duration); ok {
+		return inRange(d)
+	}
+
+	st := reflect.ValueOf(v)
+	if st.Kind() == reflect.Slice || st.Kind() == reflect.Array {
+		for i := 0; i < st.Len(); i++ {
+			if !isDuration(st.Index(i).Interface(), inRange) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return StringChecker("duration", func(s string) bool {
+		d, err := time.ParseDuration(s)
+
+		return err == nil && inRange(d)
+	}, v)
+}
+
+func DurationErr(field string, v interface{}, t Tag) string {
+	minDur, maxDur := durationBounds(t.Param)
+
+	// A range message only makes sense when the value itself is valid
+	if !Duration(v, "") {
+		return fmt.Sprintf("%s is not a valid duration", field)
+	}
+
+	switch {
+	case minDur != nil && maxDur != nil:
+		return fmt.Sprintf("%s must be between %s and %s", field, minDur, maxDur)
+	case minDur != nil:
+		return fmt.Sprintf("%s must be at least %s", field, minDur)
+	default:
+		return fmt.Sprintf("%s must be at most %s", field, maxDur)
+	}
+}
+
+// durationBounds parses the "min,max" param of the duration rule. Returns
+// nil for a bound that is not specified.
+func durationBounds(param string) (minDur, maxDur *time.Duration) {
+	if param == "" {
+		return nil, nil
+	}
+
+	bounds := strings.SplitN(param, ",", 2) //nolint:gomnd
+	minDur = asDuration(bounds[0])
+
+	if len(bounds) > 1 {
+		maxDur = asDuration(bounds[1])
+	}
+
+	return minDur, maxDur
+}
+
+// durationParam parses the param of a rule applied to a time.Duration,
+// e.g. "1s". A plain integer is interpreted as nanoseconds for backward
+// compatibility.
+func durationParam(param string) time.Duration {
+	if d, err := time.ParseDuration(param); err == nil {
+		return d
+	}
+
+	i, err := strconv.ParseInt(param, 0, 64) //nolint:gomnd
+	if err != nil {
+		panic(fmt.Sprintf("cannot cast %q to duration", param))
+	}
+
+	return time.Duration(i)
+}
+
+func asDuration(param string) *time.Duration {
+	param = strings.TrimSpace(param)
+	if param == "" {
+		return nil
+	}
+
+	d, err := time.ParseDuration(param)
+	if err != nil {
+		panic(fmt.Sprintf("cannot cast %q to duration", param))
+	}
+
+	return &d
+}
+
+// Cron tests whether a string is a valid cron expression with 5 fields
+// (minute, hour, day of month, month and day of week), or 6 fields with
+// seconds as the first field. Fields support "*", values, ranges ("1-5"),
+// lists ("1,15") and steps ("*/5"). Months and days of week may be named
+// (JAN-DEC and SUN-SAT). The macros @yearly, @annually, @monthly, @weekly,
+// @daily, @midnight and @hourly are accepted as well.
+func Cron(v interface{}, _ string) bool {
+	return StringChecker("cron", isCron, v)
+}
+
+func CronErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid cron expression", field)
+}
+
+func isCron(s string) bool {
+	if cronMacros[s] {
+		return true
+	}
+
+	fields := strings.Fields(s)
+
+	switch len(fields) {
+	case len(cronFields):
+	case len(cronFields) + 1:
+		if !cronSeconds.valid(fields[0]) {
+			return false
+		}
+
+		fields = fields[1:]
+	default:
+		return false
+	}
+
+	for i, field := range fields {
+		if !cronFields[i].valid(field) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cronField describes the values allowed in a single field of a cron
+// expression. Fields marked question accept "?" as an alternative to "*".
+type cronField struct {
+	min      int
+	max      int
+	names    map[string]int
+	question bool
+}
+
+func (f cronField) valid(field string) bool {
+	for _, item := range strings.Split(field, ",") {
+		rng, step := splitOnce(item, "/")
+		if step != nil {
+			if n, err := strconv.Atoi(*step); err != nil || n < 1 {
+				return false
+			}
+		}
+
+		if rng == "*" || (rng == "?" && f.question) {
+			continue
+		}
+
+		from, to := splitOnce(rng, "-")
+
+		start, ok := f.value(from)
+		if !ok {
+			return false
+		}
+
+		if to != nil {
+			if end, ok := f.value(*to); !ok || end < start {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (f cronField) value(s string) (int, bool) {
+	if n, ok := f.names[strings.ToUpper(s)]; ok {
+		return n, true
+	}
+
+	n, err := strconv.Atoi(s)
+
+	return n, err == nil && n >= f.min && n <= f.max
+}
+
+// splitOnce splits s around the first instance of sep. Returns nil as
+// second value if sep was not found.
+func splitOnce(s string, sep string) (string, *string) {
+	parts := strings.SplitN(s, sep, 2) //nolint:gomnd
+	if len(parts) == 1 {
+		return s, nil
+	}
+
+	return parts[0], &parts[1]
+}
+
+// dateLayout returns the layout specified as parameter, or the ISO date
+// layout if the parameter is empty.
+func dateLayout(param string) string {
+	if param == "" {
+		return isoDateLayout
+	}
+
+	return param
+}
+
+// clock determines the current time of rules accepting "now" as
+// parameter. The zero clock uses time.Now in UTC.
+type clock struct {
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	// Location is the time zone that determines today's date. Defaults
+	// to UTC.
+	Location *time.Location
+}
+
+func (c clock) now() time.Time {
+	if c.Now == nil {
+		return time.Now().UTC()
+	}
+
+	return c.Now()
+}
+
+func (c clock) location() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+
+	return c.Location
+}
+
+// today returns today's date in the location of the clock at midnight
+// UTC, like dates parsed from a string.
+func (c clock) today() time.Time {
+	now := c.now().In(c.location())
+
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// parseTimestamp parses an RFC3339 timestamp, or returns the current time
+// if timestamp is "now".
+func (c clock) parseTimestamp(timestamp string) time.Time {
+	if timestamp == "now" {
+		return c.now()
+	}
+
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		panic(err) // This is a coding error in the tag value
+	}
+
+	return t
+}
+
+// timestampString returns timestamp, or the current time in the location
+// of the clock formatted as RFC3339 if timestamp is "now".
+func (c clock) timestampString(timestamp string) string {
+	if timestamp == "now" {
+		return c.now().In(c.location()).Format(time.RFC3339)
+	}
+
+	return timestamp
+}
+
+// dateString returns the date formatted in layout, or today's date if
+// date is "now".
+func (c clock) dateString(date string, layout string) string {
+	if date == "now" {
+		return c.today().Format(layout)
+	}
+
+	return date
+}
+
+func parseDate(date string, layout string) time.Time {
+	d, err := time.Parse(layout, date)
+	if err != nil {
+		panic(err) // This is a coding error in the tag value
+	}
+
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, d.Location())
+}
+
+func Az(v interface{}, _ string) bool {
+	return RegexChecker("az_", regexpAz, v)
+}
+
+func AzErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must contain a-z, _ and not start with a _", field)
+}
+
+func AZ09(v interface{}, _ string) bool {
+	return RegexChecker("aZ09_", regexpAZ09, v)
+}
+
+func AZ09Err(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must contain 0-9, A-Z, _ and not start with a _", field)
+}
+
+func AlphaNumUnicode(v interface{}, _ string) bool {
+	return RegexChecker("alphanumunicode", regexpAlphaNumUnicode, v)
+}
+
+func AlphaNumUnicodeErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must contain only unicode letters and digits", field)
+}
+
+func Slug(v interface{}, _ string) bool {
+	return RegexChecker("slug", regexpSlug, v)
+}
+
+func SlugErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must be a valid slug (lowercase, hyphen-separated)", field)
+}
+
+func Name(v interface{}, _ string) bool {
+	return RegexChecker("name", regexpName, v)
+}
+
+func NameErr(field string, _ interface{}, _ Tag) string {
+	return nameErr(field, "")
+}
+
+// NameRule returns a "name" rule that allows the characters in extra in
+// addition to unicode letters, -,.' and spaces, e.g. NameRule("·ʻ"). The
+// characters are taken literally; characters with a special meaning in a
+// regular expression such as ] and \ are escaped.
+func NameRule(extra string) ValidationRule {
+	match := nameRegexp(extra)
+
+	return ValidationRule{
+		Tag:            "name",
+		SupportedKinds: stringListKinds,
+		Checker: func(v interface{}, _ string) bool {
+			return RegexChecker("name", match, v)
+		},
+		ErrorFunc: func(field string, _ interface{}, _ Tag) string {
+			return nameErr(field, extra)
+		},
+	}
+}
+
+func nameRegexp(extra string) *regexp.Regexp {
+	var escaped strings.Builder
+
+	for _, r := range extra {
+		if strings.ContainsRune(`\]^-[`, r) {
+			escaped.WriteRune('\\')
+		}
+
+		escaped.WriteRune(r)
+	}
+
+	chars := `\p{L},.'` + escaped.String() + `-`
+
+	return regexp.MustCompile(`^[` + chars + `][\p{L} ` + chars + `]*[` + chars + `]$`)
+}
+
+func nameErr(field string, extra string) string {
+	return fmt.Sprintf("%s must contain unicode letters -,.'%s and not start or end with a space", field, extra)
+}
+
+func Zoneinfo(v interface{}, _ string) bool {
+	val, ok := v.(string)
+	if !ok {
+		panic("invalid type for zoneinfo tag")
+	}
+
+	if val != "" {
+		_, err := time.LoadLocation(val)
+		if err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func ZoneinfoErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid zoneinfo string (example: 'Europe/Amsterdam')", field)
+}
+
+func Locale(v interface{}, _ string) bool {
+	val, ok := v.(string)
+	if !ok {
+		panic("invalid type for locale tag")
+	}
+
+	if val != "" {
+		tags := strings.Split(val, " ")
+		for _, s := range tags {
+			_, err := language.Parse(s)
+			if err != nil {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func LocaleErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must contain BCP47 language tags separated by spaces", field)
+}
+
+// StrictLocale tests whether a string contains known BCP47 language tags
+// separated by spaces in their canonical form, e.g. "en-US" is accepted
+// whereas "en_US" and "en-us" are not.
+func StrictLocale(v interface{}, _ string) bool {
+	val, ok := v.(string)
+	if !ok {
+		panic("invalid type for strictlocale tag")
+	}
+
+	if val == "" {
+		return true
+	}
+
+	for _, s := range strings.Split(val, " ") {
+		tag, err := language.Parse(s)
+		if err != nil || tag.String() != s {
+			return false
+		}
+	}
+
+	return true
+}
+
+func StrictLocaleErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must contain canonical BCP47 language tags separated by spaces (example: 'en-US')", field)
+}
+
+// URL tests whether a string is a valid absolute url. Optionally restrict the
+// allowed schemes by specifying them as parameter separated by spaces or
+// escaped commas, e.g. "url=https" or "url=http https". The options
+// "nouserinfo" and "nofragment" reject urls containing credentials or a
+// fragment respectively, e.g. "url=https nouserinfo nofragment".
+func URL(v interface{}, param string) bool {
+	val, ok := v.(string)
+	if !ok {
+		panic("invalid type for url tag")
+	}
+
+	if val == "" {
+		return true
+	}
+
+	schemes, noUserInfo, noFragment := urlParams(param)
+
+	// Strip # prior to validation
+	var i int
+	if i = strings.Index(val, "#"); i > -1 {
+		if noFragment {
+			return false
+		}
+
+		val = val[:i]
+	}
+
+	parsedURL, err := url.ParseRequestURI(val)
+	if err != nil || parsedURL.Scheme == "" {
+		return false
+	}
+
+	if noUserInfo && parsedURL.User != nil {
+		return false
+	}
+
+	if len(schemes) == 0 {
+		return true
+	}
+
+	for _, scheme := range schemes {
+		if strings.EqualFold(parsedURL.Scheme, scheme) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func URLErr(field string, _ interface{}, t Tag) string {
+	schemes, noUserInfo, noFragment := urlParams(t.Param)
+
+	msg := fmt.Sprintf("%s is not a valid url", field)
+	if len(schemes) > 0 {
+		msg = fmt.Sprintf("%s is not a valid %s url", field, strings.Join(schemes, " or "))
+	}
+
+	var options []string
+	if noUserInfo {
+		options = append(options, "no credentials")
+	}
+
+	if noFragment {
+		options = append(options, "no fragment")
+	}
+
+	if len(options) > 0 {
+		msg += " (" + strings.Join(options, ", ") + ")"
+	}
+
+	return msg
+}
+
+// RelURL tests whether a string is a relative url consisting of an absolute
+// path without scheme or host, e.g. "/dashboard?tab=1". Protocol-relative
+// urls such as "//evil.com" are rejected, which makes the rule suitable to
+// validate redirect targets.
+func RelURL(v interface{}, _ string) bool {
+	return StringChecker("relurl", isRelURL, v)
+}
+
+func RelURLErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid relative URL", field)
+}
+
+func isRelURL(s string) bool {
+	// browsers treat a backslash like a slash and ignore additional
+	// slashes, e.g. "/\evil.com" and "///evil.com" are protocol-relative too
+	if strings.HasPrefix(s, "//") || strings.ContainsRune(s, '\\') || containsControl(s) {
+		return false
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+
+	return u.Scheme == "" && u.Host == "" &&
+		strings.HasPrefix(u.Path, "/") && !strings.HasPrefix(u.Path, "//")
+}
+
+// urlParams splits the param of the url rule into the allowed schemes and
+// its options.
+func urlParams(param string) (schemes []string, noUserInfo bool, noFragment bool) {
+	for _, p := range paramList(param) {
+		switch p {
+		case "nouserinfo":
+			noUserInfo = true
+		case "nofragment":
+			noFragment = true
+		default:
+			schemes = append(schemes, p)
+		}
+	}
+
+	return schemes, noUserInfo, noFragment
+}
+
+// paramList splits a param containing multiple values separated by spaces
+// or (escaped) commas, e.g. "http https".
+func paramList(param string) []string {
+	return strings.FieldsFunc(param, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
+// MimeType tests whether a string is a well-formed media type, e.g.
+// "image/png" or "text/html; charset=utf-8". The optional param is a list
+// of accepted media types, e.g. "mimetype=image/png image/jpeg". A subtype
+// of "*" accepts any subtype, e.g. "image/*". Parameters of the media type
+// are ignored when comparing.
+func MimeType(v interface{}, param string) bool {
+	allowed := paramList(param)
+
+	return StringChecker("mimetype", func(s string) bool {
+		mediaType, _, err := mime.ParseMediaType(s)
+		if err != nil || !strings.Contains(mediaType, "/") {
+			return false
+		}
+
+		if len(allowed) == 0 {
+			return true
+		}
+
+		for _, a := range allowed {
+			a = strings.ToLower(a)
+			if a == mediaType || (strings.HasSuffix(a, "/*") && strings.HasPrefix(mediaType, a[:len(a)-1])) {
+				return true
+			}
+		}
+
+		return false
+	}, v)
+}
+
+func MimeTypeErr(field string, _ interface{}, t Tag) string {
+	if t.Param == "" {
+		return fmt.Sprintf("%s is not a valid media type", field)
+	}
+
+	return fmt.Sprintf("%s must be one of %s", field, strings.Join(paramList(t.Param), ", "))
+}
+
+// FileName tests whether a string is a single filename that is safe to join
+// with a directory; it may not contain path separators or control characters
+// and may not be "." or "..". The optional param is a list of accepted
+// extensions, e.g. "filename=.pdf .png".
+func FileName(v interface{}, param string) bool {
+	extensions := paramList(param)
+
+	return StringChecker("filename", func(s string) bool {
+		if s == "." || s == ".." || strings.ContainsAny(s, `/\`) || containsControl(s) {
+			return false
+		}
+
+		return hasExtension(s, extensions)
+	}, v)
+}
+
+func FileNameErr(field string, _ interface{}, t Tag) string {
+	if t.Param == "" {
+		return fmt.Sprintf("%s is not a safe filename", field)
+	}
+
+	return fmt.Sprintf("%s is not a safe filename ending with %s", field, strings.Join(paramList(t.Param), " or "))
+}
+
+// FilePath tests whether a string is a relative slash-separated path that
+// stays within the directory it is joined with after cleaning, e.g.
+// "docs/a.pdf" is accepted whereas "/etc/passwd" and "docs/../../a.pdf" are
+// not. Backslashes and control characters are not allowed. The optional
+// param is a list of accepted extensions, e.g. "filepath=.pdf .png".
+func FilePath(v interface{}, param string) bool {
+	extensions := paramList(param)
+
+	return StringChecker("filepath", func(s string) bool {
+		if strings.HasPrefix(s, "/") || strings.Contains(s, `\`) || containsControl(s) {
+			return false
+		}
+
+		cleaned := path.Clean(s)
+		if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return false
+		}
+
+		return hasExtension(cleaned, extensions)
+	}, v)
+}
+
+func FilePathErr(field string, _ interface{}, t Tag) string {
+	if t.Param == "" {
+		return fmt.Sprintf("%s is not a safe relative path", field)
+	}
+
+	return fmt.Sprintf("%s is not a safe relative path ending with %s", field, strings.Join(paramList(t.Param), " or "))
+}
+
+// PrintASCII tests whether a string contains only printable ASCII
+// characters, i.e. runes between 0x20 (space) and 0x7E (~).
+func PrintASCII(v interface{}, _ string) bool {
+	return StringChecker("printascii", func(s string) bool {
+		for _, r := range s {
+			if r < ' ' || r > '~' {
+				return false
+			}
+		}
+
+		return true
+	}, v)
+}
+
+func PrintASCIIErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must contain only printable ASCII characters", field)
+}
+
+// NoControl tests whether a string contains no Unicode control characters
+// such as newlines, tabs, NUL or DEL.
+func NoControl(v interface{}, _ string) bool {
+	return StringChecker("nocontrol", func(s string) bool {
+		return !containsControl(s)
+	}, v)
+}
+
+func NoControlErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must not contain control characters", field)
+}
+
+// Lowercase tests whether a string is entirely lowercase. Characters without
+// case such as digits are allowed.
+func Lowercase(v interface{}, _ string) bool {
+	return StringChecker("lowercase", func(s string) bool {
+		return s == strings.ToLower(s)
+	}, v)
+}
+
+func LowercaseErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must be lowercase", field)
+}
+
+// Uppercase tests whether a string is entirely uppercase. Characters without
+// case such as digits are allowed.
+func Uppercase(v interface{}, _ string) bool {
+	return StringChecker("uppercase", func(s string) bool {
+		return s == strings.ToUpper(s)
+	}, v)
+}
+
+func UppercaseErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must be uppercase", field)
+}
+
+func containsControl(s string) bool {
+	return strings.IndexFunc(s, unicode.IsControl) >= 0
+}
+
+// hasExtension reports whether the extension of a filename matches one of the
+// given extensions case-insensitively; an empty list accepts any filename.
+func hasExtension(name string, extensions []string) bool {
+	if len(extensions) == 0 {
+		return true
+	}
+
+	ext := path.Ext(name)
+	for _, e := range extensions {
+		if strings.EqualFold(ext, e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Hostname tests whether a string is a valid hostname as defined by
+// RFC 1123, e.g. "localhost" or "www.example.com".
+func Hostname(v interface{}, _ string) bool {
+	return StringChecker("hostname", isHostname, v)
+}
+
+func HostnameErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid hostname", field)
+}
+
+// FQDN tests whether a string is a fully qualified domain name; a hostname
+// of at least two labels ending with a top-level domain, e.g. "example.com".
+// A trailing dot is allowed.
+func FQDN(v interface{}, _ string) bool {
+	return StringChecker("fqdn", isFQDN, v)
+}
+
+func FQDNErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid fully qualified domain name", field)
+}
+
+func isHostname(s string) bool {
+	if len(s) > 253 { //nolint:gomnd
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if !regexpHostnameLabel.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isFQDN(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+
+	i := strings.LastIndex(s, ".")
+	if i < 0 {
+		return false
+	}
+
+	return isHostname(s) && regexpTLD.MatchString(s[i+1:])
+}
+
+// Port tests whether an integer or numeric string is a valid network
+// port number between 1 and 65535. Port 0 is not allowed since it is
+// reserved and cannot be connected to.
+func Port(v interface{}, _ string) bool {
+	st := reflect.ValueOf(v)
+
+	switch st.Kind() {
+	case reflect.String:
+		if st.String() == "" {
+			return true
+		}
+
+		port, err := strconv.ParseUint(st.String(), 10, 16) //nolint:gomnd
+
+		return err == nil && port > 0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return st.Int() > 0 && st.Int() <= 65535
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return st.Uint() > 0 && st.Uint() <= 65535
+	default:
+		panic("invalid type for port tag")
+	}
+}
+
+func PortErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid port number", field)
+}
+
+// MAC tests whether a string is a valid IEEE 802 MAC-48, EUI-48, EUI-64 or
+// 20-octet IP over InfiniBand address in colon, hyphen or dotted notation.
+func MAC(v interface{}, _ string) bool {
+	return StringChecker("mac", func(s string) bool {
+		_, err := net.ParseMAC(s)
+
+		return err == nil
+	}, v)
+}
+
+func MACErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid MAC address", field)
+}
+
+func Email(v interface{}, _ string) bool {
+	return RegexChecker("email", regexpEmail, v)
+}
+
+func EmailErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid email", field)
+}
+
+// NormalizeEmail returns an email address with surrounding whitespace
+// removed and its domain lowercased, e.g. " John@Example.COM " becomes
+// "John@example.com". The local part is kept as-is as it may be
+// case-sensitive. Returns a FieldError like Var if the address is empty
+// or invalid.
+func NormalizeEmail(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if err := Var(s, "required,email"); err != nil {
+		return "", err
+	}
+
+	i := strings.LastIndex(s, "@")
+
+	return s[:i+1] + strings.ToLower(s[i+1:]), nil
+}
+
+func ResourceName(v interface{}, _ string) bool {
+	return defaultResources.name(v)
+}
+
+func ResourceNameErr(field string, _ interface{}, _ Tag) string {
+	return defaultResources.nameErr(field)
+}
+
+func ResourcePattern(v interface{}, _ string) bool {
+	return defaultResources.pattern(v)
+}
 
-func parseDate(date string) time.Time {
-	if date == "now" {
-		return time.Now().UTC()
+func ResourcePatternErr(field string, _ interface{}, _ Tag) string {
+	return defaultResources.patternErr(field)
+}
+
+func resourceNameCtx(ctx context.Context, v interface{}, _ string) bool {
+	return settingsFrom(ctx).resourceMatcher().name(v)
+}
+
+func resourceNameErrCtx(ctx context.Context, field string, _ interface{}, _ Tag) string {
+	return settingsFrom(ctx).resourceMatcher().nameErr(field)
+}
+
+func resourcePatternCtx(ctx context.Context, v interface{}, _ string) bool {
+	return settingsFrom(ctx).resourceMatcher().pattern(v)
+}
+
+func resourcePatternErrCtx(ctx context.Context, field string, _ interface{}, _ Tag) string {
+	return settingsFrom(ctx).resourceMatcher().patternErr(field)
+}
+
+// ResourceNameRule returns a "resourcename" rule for resource names
+// starting with the given prefix instead of "mtx:", e.g. "urn:".
+func ResourceNameRule(prefix string) ValidationRule {
+	m := newResourceMatcher(prefix)
+
+	return ValidationRule{
+		Tag:            "resourcename",
+		SupportedKinds: stringListKinds,
+		Checker: func(v interface{}, _ string) bool {
+			return m.name(v)
+		},
+		ErrorFunc: func(field string, _ interface{}, _ Tag) string {
+			return m.nameErr(field)
+		},
 	}
+}
 
-	d, err := time.Parse("2006-01-02", date)
-	if err != nil {
-		panic(err) // This is a coding error in the tag value
+// ResourcePatternRule returns a "resourcepattern" rule for resource
+// patterns starting with the given prefix instead of "mtx:", e.g. "urn:".
+func ResourcePatternRule(prefix string) ValidationRule {
+	m := newResourceMatcher(prefix)
+
+	return ValidationRule{
+		Tag:            "resourcepattern",
+		SupportedKinds: stringListKinds,
+		Checker: func(v interface{}, _ string) bool {
+			return m.pattern(v)
+		},
+		ErrorFunc: func(field string, _ interface{}, _ Tag) string {
+			return m.patternErr(field)
+		},
 	}
+}
 
-	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, d.Location())
+// resourceMatcher matches resource names and patterns with a prefix.
+type resourceMatcher struct {
+	prefix        string
+	nameRegexp    *regexp.Regexp
+	patternRegexp *regexp.Regexp
 }
 
-func nowToDateString(date string) string {
-	if date == "now" {
-		return time.Now().UTC().Format("2006-01-02")
+func newResourceMatcher(prefix string) *resourceMatcher {
+	return &resourceMatcher{
+		prefix:        prefix,
+		nameRegexp:    resourceNameRegexp(prefix),
+		patternRegexp: resourcePatternRegexp(prefix),
 	}
+}
 
-	return date
+func (m *resourceMatcher) name(v interface{}) bool {
+	return RegexChecker("resourcename", m.nameRegexp, v)
 }
 
-func Az(v interface{}, _ string) bool {
-	return RegexChecker("az_", regexpAz, v)
+func (m *resourceMatcher) nameErr(field string) string {
+	return resourceNameErr(field, m.prefix)
 }
 
-func AzErr(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s must contain a-z, _ and not start with a _", field)
+func (m *resourceMatcher) pattern(v interface{}) bool {
+	return RegexChecker("resourcepattern", m.patternRegexp, v)
 }
 
-func AZ09(v interface{}, _ string) bool {
-	return RegexChecker("aZ09_", regexpAZ09, v)
+func (m *resourceMatcher) patternErr(field string) string {
+	return resourcePatternErr(field, m.prefix)
 }
 
-func AZ09Err(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s must contain 0-9, A-Z, _ and not start with a _", field)
+func resourceNameRegexp(prefix string) *regexp.Regexp {
+	return regexp.MustCompile("^" + regexp.QuoteMeta(prefix) + "[a-z0-9-/]+(:[a-z0-9-/]+)*$")
 }
 
-func Name(v interface{}, _ string) bool {
-	return RegexChecker("name", regexpName, v)
+func resourcePatternRegexp(prefix string) *regexp.Regexp {
+	return regexp.MustCompile("^" + regexp.QuoteMeta(prefix) + "[a-z0-9-*/]+(:[a-z0-9-*/]+)*$")
 }
 
-func NameErr(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s must contain unicode letters -,.' and not start or end with a space", field)
+func resourceNameErr(field string, prefix string) string {
+	return fmt.Sprintf("%s must start with '%s' and may contain: a-z, 0-9, -, /, and :", field, prefix)
 }
 
-func Zoneinfo(v interface{}, _ string) bool {
-	val, ok := v.(string)
-	if !ok {
-		panic("invalid type for zoneinfo tag")
+func resourcePatternErr(field string, prefix string) string {
+	return fmt.Sprintf("%s must start with '%s' and may contain: a-z, 0-9, -, /, *, and :", field, prefix)
+}
+
+// Semver tests whether a string is a valid semantic version as defined
+// by https://semver.org, e.g. "1.2.3", "1.0.0-alpha.1" or "1.2.3+build.5".
+func Semver(v interface{}, _ string) bool {
+	return RegexChecker("semver", regexpSemver, v)
+}
+
+func SemverErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid semantic version", field)
+}
+
+// HexColor tests whether a string is a hex color value in the 3, 6 or
+// 8 digit (with alpha channel) form, e.g. "#fff" or "#ffffff80".
+func HexColor(v interface{}, _ string) bool {
+	return RegexChecker("hexcolor", regexpHexColor, v)
+}
+
+func HexColorErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid hex color", field)
+}
+
+// Base64 tests whether a string is encoded using standard base64
+// encoding (RFC 4648). Padding is required.
+func Base64(v interface{}, _ string) bool {
+	return StringChecker("base64", isBase64(base64.StdEncoding), v)
+}
+
+func Base64Err(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not valid base64", field)
+}
+
+// EAN tests whether a string is a valid EAN-8, UPC-A (12 digits) or
+// EAN-13 barcode including a correct check digit. Spaces and hyphens
+// are ignored.
+func EAN(v interface{}, _ string) bool {
+	return StringChecker("ean", isEAN, v)
+}
+
+func EANErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not a valid barcode", field)
+}
+
+func isEAN(s string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(s)
+
+	switch len(digits) {
+	case 8, 12, 13: //nolint:gomnd
+	default:
+		return false
 	}
 
-	if val != "" {
-		_, err := time.LoadLocation(val)
-		if err != nil {
+	sum := 0
+
+	// Weigh digits 3 and 1 alternately starting right of the check digit
+	for i := len(digits) - 2; i >= 0; i-- {
+		if digits[i] < '0' || digits[i] > '9' {
 			return false
 		}
+
+		d := int(digits[i] - '0')
+		if (len(digits)-i)%2 == 0 {
+			d *= 3
+		}
+
+		sum += d
 	}
 
-	return true
+	check := (10 - sum%10) % 10 //nolint:gomnd
+
+	return int(digits[len(digits)-1]-'0') == check
 }
 
-func ZoneinfoErr(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s is not a valid zoneinfo string (example: 'Europe/Amsterdam')", field)
+// Base64URL tests whether a string is encoded using the URL-safe
+// base64 alphabet (RFC 4648). Padding is not allowed.
+func Base64URL(v interface{}, _ string) bool {
+	return StringChecker("base64url", isBase64(base64.RawURLEncoding), v)
 }
 
-func Locale(v interface{}, _ string) bool {
+func Base64URLErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is not valid unpadded URL-safe base64", field)
+}
+
+func isBase64(enc *base64.Encoding) func(string) bool {
+	return func(s string) bool {
+		_, err := enc.DecodeString(s)
+
+		return err == nil
+	}
+}
+
+// Password tests whether a string meets all password requirements specified
+// as parameter separated by spaces or escaped commas, e.g.
+// "password=min8 upper lower digit special". Supported requirements are:
+//   - minN: at least N characters long.
+//   - upper: at least one uppercase letter.
+//   - lower: at least one lowercase letter.
+//   - digit: at least one digit.
+//   - special: at least one punctuation character or symbol.
+func Password(v interface{}, param string) bool {
 	val, ok := v.(string)
 	if !ok {
-		panic("invalid type for locale tag")
+		panic("invalid type for password tag")
 	}
 
-	if val != "" {
-		tags := strings.Split(val, " ")
-		for _, s := range tags {
-			_, err := language.Parse(s)
-			if err != nil {
-				return false
+	return val == "" || failedPasswordRequirement(val, param) == ""
+}
+
+func PasswordErr(field string, v interface{}, t Tag) string {
+	val, _ := v.(string)
+
+	return fmt.Sprintf("%s must %s", field, failedPasswordRequirement(val, t.Param))
+}
+
+// failedPasswordRequirement returns a description of the first requirement
+// the password does not meet, or an empty string if all requirements are met.
+func failedPasswordRequirement(password string, param string) string {
+	for _, req := range strings.FieldsFunc(param, func(r rune) bool { return r == ',' || r == ' ' }) {
+		switch {
+		case strings.HasPrefix(req, "min"):
+			if length := req[len("min"):]; int64(utf8.RuneCountInString(password)) < asInt(length) {
+				return fmt.Sprintf("be at least %s characters long", length)
+			}
+		case req == "upper":
+			if strings.IndexFunc(password, unicode.IsUpper) < 0 {
+				return "contain at least one uppercase letter"
+			}
+		case req == "lower":
+			if strings.IndexFunc(password, unicode.IsLower) < 0 {
+				return "contain at least one lowercase letter"
+			}
+		case req == "digit":
+			if strings.IndexFunc(password, unicode.IsDigit) < 0 {
+				return "contain at least one digit"
 			}
+		case req == "special":
+			if strings.IndexFunc(password, isSpecial) < 0 {
+				return "contain at least one special character"
+			}
+		default:
+			panic(fmt.Sprintf("unknown password requirement %q", req))
 		}
 	}
 
-	return true
+	return ""
 }
 
-func LocaleErr(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s must contain BCP47 language tags separated by spaces", field)
+func isSpecial(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
 }
 
-func URL(v interface{}, _ string) bool {
+// StartsWith tests whether a string starts with param. Escape commas in
+// param with a backslash, e.g. "startswith=a\,b".
+func StartsWith(v interface{}, param string) bool {
 	val, ok := v.(string)
 	if !ok {
-		panic("invalid type for url tag")
+		panic("invalid type for startswith tag")
 	}
 
-	if val == "" {
-		return true
+	return val == "" || strings.HasPrefix(val, param)
+}
+
+func StartsWithErr(field string, _ interface{}, t Tag) string {
+	return fmt.Sprintf("%s must start with %q", field, t.Param)
+}
+
+// EndsWith tests whether a string ends with param. Escape commas in
+// param with a backslash, e.g. "endswith=a\,b".
+func EndsWith(v interface{}, param string) bool {
+	val, ok := v.(string)
+	if !ok {
+		panic("invalid type for endswith tag")
 	}
 
-	// Strip # prior to validation
-	var i int
-	if i = strings.Index(val, "#"); i > -1 {
-		val = val[:i]
+	return val == "" || strings.HasSuffix(val, param)
+}
+
+func EndsWithErr(field string, _ interface{}, t Tag) string {
+	return fmt.Sprintf("%s must end with %q", field, t.Param)
+}
+
+// Contains tests whether a string contains param. Escape commas in
+// param with a backslash, e.g. "contains=a\,b".
+func Contains(v interface{}, param string) bool {
+	val, ok := v.(string)
+	if !ok {
+		panic("invalid type for contains tag")
 	}
 
-	parsedURL, err := url.ParseRequestURI(val)
-	if err != nil || parsedURL.Scheme == "" {
-		return false
+	return val == "" || strings.Contains(val, param)
+}
+
+func ContainsErr(field string, _ interface{}, t Tag) string {
+	return fmt.Sprintf("%s must contain %q", field, t.Param)
+}
+
+// Eq tests whether a string, number or boolean equals the given literal,
+// e.g. "eq=v1". Numbers are compared by value, so "eq=10" matches 10.0.
+func Eq(v interface{}, param string) bool {
+	return equalsLiteral("eq", v, param)
+}
+
+func EqErr(field string, _ interface{}, t Tag) string {
+	return fmt.Sprintf("%s must be equal to %s", field, t.Param)
+}
+
+// Ne tests whether a string, number or boolean does not equal the given
+// literal, e.g. "ne=deleted". Numbers are compared by value.
+func Ne(v interface{}, param string) bool {
+	return !equalsLiteral("ne", v, param)
+}
+
+func NeErr(field string, _ interface{}, t Tag) string {
+	return fmt.Sprintf("%s must not be equal to %s", field, t.Param)
+}
+
+// equalsLiteral compares a value against a tag parameter. Panics if the
+// parameter cannot be cast to the numeric type of the value.
+func equalsLiteral(tagName string, v interface{}, param string) bool {
+	st := reflect.ValueOf(v)
+
+	switch st.Kind() {
+	case reflect.String:
+		return st.String() == param
+	case reflect.Bool:
+		return strconv.FormatBool(st.Bool()) == param
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return st.Int() == asInt(param)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return st.Uint() == asUint(param)
+	case reflect.Float32, reflect.Float64:
+		return st.Float() == asFloat(param)
+	default:
+		panic(fmt.Sprintf("invalid type for %s tag: %s", tagName, kindName(v)))
 	}
+}
 
-	return true
+// DivisibleBy tests whether an integer is divisible by the given number,
+// e.g. "divisibleby=4". Panics if the param is zero.
+func DivisibleBy(v interface{}, param string) bool {
+	st := reflect.ValueOf(v)
+
+	switch st.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		d := asInt(param)
+		if d == 0 {
+			panic("divisibleby tag requires a non-zero param")
+		}
+
+		return st.Int()%d == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		d := asUint(param)
+		if d == 0 {
+			panic("divisibleby tag requires a non-zero param")
+		}
+
+		return st.Uint()%d == 0
+	default:
+		panic("invalid type for divisibleby tag")
+	}
 }
 
-func URLErr(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s is not a valid url", field)
+func DivisibleByErr(field string, _ interface{}, t Tag) string {
+	return fmt.Sprintf("%s must be divisible by %s", field, t.Param)
 }
 
-func Email(v interface{}, _ string) bool {
-	return RegexChecker("email", regexpEmail, v)
+// Positive tests whether a number is greater than zero.
+func Positive(v interface{}, _ string) bool {
+	return sign("positive", v) > 0
 }
 
-func EmailErr(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s is not a valid email", field)
+func PositiveErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must be positive", field)
 }
 
-func ResourceName(v interface{}, _ string) bool {
-	return RegexChecker("resourcename", regexpResourceName, v)
+// Negative tests whether a number is less than zero. An unsigned number
+// never passes.
+func Negative(v interface{}, _ string) bool {
+	return sign("negative", v) < 0
 }
 
-func ResourceNameErr(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s must start with 'mtx:' and may contain: a-z, 0-9, -, /, and :", field)
+func NegativeErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must be negative", field)
 }
 
-func ResourcePattern(v interface{}, _ string) bool {
-	return RegexChecker("resourcepattern", regexpResourcePattern, v)
+// NonNegative tests whether a number is zero or greater.
+func NonNegative(v interface{}, _ string) bool {
+	return sign("nonnegative", v) >= 0
 }
 
-func ResourcePatternErr(field string, _ interface{}, _ Tag) string {
-	return fmt.Sprintf("%s must start with 'mtx:' and may contain: a-z, 0-9, -, /, *, and :", field)
+func NonNegativeErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must not be negative", field)
+}
+
+// NonPositive tests whether a number is zero or less. An unsigned number
+// only passes when it is zero.
+func NonPositive(v interface{}, _ string) bool {
+	return sign("nonpositive", v) <= 0
+}
+
+func NonPositiveErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must not be positive", field)
+}
+
+// sign returns -1, 0 or 1 depending on whether a number is negative, zero
+// or positive. NaN is reported as 0. Panics if v is not a number.
+func sign(tagName string, v interface{}) int {
+	st := reflect.ValueOf(v)
+
+	switch st.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch i := st.Int(); {
+		case i > 0:
+			return 1
+		case i < 0:
+			return -1
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if st.Uint() > 0 {
+			return 1
+		}
+	case reflect.Float32, reflect.Float64:
+		switch f := st.Float(); {
+		case f > 0:
+			return 1
+		case f < 0:
+			return -1
+		}
+	default:
+		panic(fmt.Sprintf("invalid type for %s tag: %s", tagName, kindName(v)))
+	}
+
+	return 0
+}
+
+// Has tests whether an array or slice contains an element equal to the
+// given parameter. Strings are compared as-is whereas numbers are compared
+// by value, e.g. "has=1.5" matches float64(1.5).
+func Has(v interface{}, param string) bool {
+	st := reflect.ValueOf(v)
+	if st.Kind() != reflect.Slice && st.Kind() != reflect.Array {
+		panic("invalid type for has tag")
+	}
+
+	for i := 0; i < st.Len(); i++ {
+		if equalsParam(st.Index(i), param) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func HasErr(field string, _ interface{}, t Tag) string {
+	return fmt.Sprintf("%s must contain %s", field, t.Param)
+}
+
+// Unique tests whether all elements of an array or slice are distinct.
+// For structs, or pointers to structs, the param may name the field to
+// compare, e.g. "unique=ID". Elements must be comparable.
+func Unique(v interface{}, param string) bool {
+	st := reflect.ValueOf(v)
+	if st.Kind() != reflect.Slice && st.Kind() != reflect.Array {
+		panic("invalid type for unique tag")
+	}
+
+	seen := make(map[interface{}]struct{}, st.Len())
+
+	for i := 0; i < st.Len(); i++ {
+		elem := st.Index(i)
+		if param != "" {
+			elem = structField(elem, param)
+		}
+
+		for elem.Kind() == reflect.Interface && !elem.IsNil() {
+			elem = elem.Elem()
+		}
+
+		if !elem.IsValid() || !elem.Type().Comparable() {
+			panic("invalid type for unique tag")
+		}
+
+		key := elem.Interface()
+		if _, ok := seen[key]; ok {
+			return false
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	return true
+}
+
+func UniqueErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s must not contain duplicates", field)
+}
+
+// structField returns the exported field of a struct, or of a pointer to
+// a struct, used by the unique tag. Panics if the field does not exist.
+func structField(v reflect.Value, name string) reflect.Value {
+	for (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		panic("invalid type for unique tag")
+	}
+
+	if f, ok := v.Type().FieldByName(name); !ok || f.PkgPath != "" {
+		panic(fmt.Sprintf("unknown field %q for unique tag", name))
+	}
+
+	return v.FieldByName(name)
+}
+
+// equalsParam tests whether a string or number equals the given parameter.
+func equalsParam(v reflect.Value, param string) bool {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String() == param
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(param, 0, 64)
+
+		return err == nil && v.Int() == i
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		i, err := strconv.ParseUint(param, 0, 64)
+
+		return err == nil && v.Uint() == i
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(param, 64)
+
+		return err == nil && v.Float() == f
+	case reflect.Interface, reflect.Ptr:
+		return !v.IsNil() && equalsParam(v.Elem(), param)
+	default:
+		return false
+	}
 }
 
 func RegexChecker(tagName string, match *regexp.Regexp, v interface{}) bool {
@@ -546,8 +2627,18 @@ func RegexChecker(tagName string, match *regexp.Regexp, v interface{}) bool {
 
 		return match.MatchString(st.String())
 	default:
-		panic(fmt.Sprintf("invalid type for %s tag", tagName))
+		panic(fmt.Sprintf("invalid type for %s tag: %s", tagName, kindName(v)))
+	}
+}
+
+// concatKinds returns a new slice containing all given kinds.
+func concatKinds(kinds ...[]reflect.Kind) []reflect.Kind {
+	var result []reflect.Kind
+	for _, k := range kinds {
+		result = append(result, k...)
 	}
+
+	return result
 }
 
 func asInt(param string) int64 {
@@ -576,3 +2667,28 @@ func asFloat(param string) float64 {
 
 	return i
 }
+
+// StringChecker runs check on a string or on every string within an array
+// or slice. Empty strings are always valid. Panics if v is of any other type.
+func StringChecker(tagName string, check func(string) bool, v interface{}) bool {
+	st := reflect.ValueOf(v)
+
+	switch st.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < st.Len(); i++ {
+			if !StringChecker(tagName, check, st.Index(i).Interface()) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.String:
+		if st.String() == "" {
+			return true
+		}
+
+		return check(st.String())
+	default:
+		panic(fmt.Sprintf("invalid type for %s tag: %s", tagName, kindName(v)))
+	}
+}
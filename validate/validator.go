@@ -18,13 +18,39 @@
 package validate
 
 import (
+	"context"
+	"encoding"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
+
+	"github.com/rivo/uniseg"
+)
+
+const (
+	// varField is the field name used in error descriptions of Var.
+	varField = "Value"
+
+	// omitEmptyKeyword skips further validation of empty values when it is
+	// the first tag.
+	omitEmptyKeyword = "omitempty"
+
+	// diveKeyword applies the tags that follow to the keys and values of
+	// a map instead of the map itself.
+	diveKeyword = "dive"
+
+	// keysKeyword and endKeysKeyword enclose the tags applied to map keys
+	// directly after diveKeyword.
+	keysKeyword    = "keys"
+	endKeysKeyword = "endkeys"
 )
 
 var (
@@ -32,8 +58,11 @@ var (
 	// is used with an unsupported variable type.
 	ErrUnsupported = errors.New("unsupported type")
 
-	tagCache   = sync.Map{}
 	sepPattern = regexp.MustCompile(`((?:^|[^\\])(?:\\\\)*),`)
+
+	// lengthRules are the rules measuring string length, these are
+	// affected by WithTrimStrings.
+	lengthRules = map[string]bool{"gte": true, "lte": true, "len": true}
 )
 
 // FieldErrors contains an array of errors returned by the validation
@@ -49,7 +78,7 @@ func (ve FieldErrors) Error() string {
 			err += ", "
 		}
 
-		err += fe.Field
+		err += fe.fieldName()
 	}
 
 	if len(ve) == 1 {
@@ -59,17 +88,108 @@ func (ve FieldErrors) Error() string {
 	return "fields are invalid: " + err
 }
 
+// MarshalJSON implements the json.Marshaler interface and returns the
+// errors as {"errors":[{"field":"Name","description":"Name is required"}]}.
+func (ve FieldErrors) MarshalJSON() ([]byte, error) {
+	errs := []FieldError(ve)
+	if errs == nil {
+		errs = []FieldError{}
+	}
+
+	return json.Marshal(struct { //nolint:wrapcheck
+		Errors []FieldError `json:"errors"`
+	}{errs})
+}
+
+// ByField returns the errors of the field with the given name, e.g. to
+// show them next to an input. The name must match the Field of the error
+// exactly, e.g. "Address.City" when using WithFullErrorPath.
+func (ve FieldErrors) ByField(name string) []FieldError {
+	var result []FieldError
+
+	for _, fe := range ve {
+		if fe.Field == name {
+			result = append(result, fe)
+		}
+	}
+
+	return result
+}
+
+// Has returns true if the field with the given name has an error.
+func (ve FieldErrors) Has(name string) bool {
+	for _, fe := range ve {
+		if fe.Field == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Prefix returns a copy of err with the field of every error prefixed by
+// prefix and a dot, e.g. "address.City" for prefix "address". Use it to
+// combine the errors of separately validated values; see WithFullErrorPath
+// for nested structs.
+//
+// Returns err as-is if it is not a FieldErrors.
+func Prefix(prefix string, err error) error {
+	var errs FieldErrors
+	if !errors.As(err, &errs) {
+		return err
+	}
+
+	result := make(FieldErrors, 0, len(errs))
+
+	for _, fe := range errs {
+		fe.Field = prefix + "." + fe.Field
+		result = append(result, fe)
+	}
+
+	return result
+}
+
 // FieldError contains an error message for a given field.
 type FieldError struct {
 	Field       string
 	Description string
+
+	// Tag is the name of the rule that failed, e.g. "required".
+	Tag string
+
+	// Param is the parameter of the rule that failed, e.g. "3" for "gte=3".
+	Param string
 }
 
-// Error implements the Error interface.
+// Error implements the Error interface. Returns "value is invalid" for
+// the error of a standalone value without field name, see Var.
 func (fe FieldError) Error() string {
+	if fe.Field == "" {
+		return "value is invalid"
+	}
+
 	return "field is invalid: " + fe.Field
 }
 
+// fieldName returns the field of the error, or "value" for a standalone
+// value without field name.
+func (fe FieldError) fieldName() string {
+	if fe.Field == "" {
+		return "value"
+	}
+
+	return fe.Field
+}
+
+// MarshalJSON implements the json.Marshaler interface and returns the
+// error as {"field":"Name","description":"Name is required"}.
+func (fe FieldError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct { //nolint:wrapcheck
+		Field       string `json:"field"`
+		Description string `json:"description"`
+	}{fe.Field, fe.Description})
+}
+
 // ValidationRule specifies the validation functions ("Checkers")
 // and error message function ("ErrorFunc") for a given Tag.
 //
@@ -81,9 +201,47 @@ type ValidationRule struct {
 	// Checker returns true when a value is valid, otherwise false.
 	Checker RuleChecker
 
+	// CheckerCtx is a context-aware alternative to Checker. When set
+	// it is used instead of Checker.
+	CheckerCtx RuleCheckerCtx
+
+	// CheckerStruct is an alternative to Checker that receives the struct
+	// containing the field, e.g. to compare the field with another field.
+	// When set it is used instead of Checker and CheckerCtx.
+	CheckerStruct RuleCheckerStruct
+
 	// ErrorFunc is called when Checker returned false. The
 	// ErrorFunc returns a proper error message.
 	ErrorFunc RuleErrorFunc
+
+	// StopOnSuccess skips all remaining tags of a field when Checker
+	// returned true.
+	StopOnSuccess bool
+
+	// StopOnFailure skips all remaining tags of a field without an error
+	// when Checker returned false, e.g. the "optional" rule stops when the
+	// value is empty. A failing rule without ErrorFunc that is not
+	// StopOnFailure reports the default message, see SetDefaultMessage.
+	StopOnFailure bool
+
+	// Presence passes a non-nil pointer to Checker instead of the value it
+	// points to, e.g. the "required" rule treats a *int pointing to 0 as
+	// present.
+	Presence bool
+
+	// SupportedKinds are the kinds of values the rule supports, e.g.
+	// reflect.String. When set, a value of any other kind panics with the
+	// tag and the kind of the value before Checker is called. When empty
+	// the Checker is responsible for handling unsupported values.
+	SupportedKinds []reflect.Kind
+
+	// errorFuncCtx is used instead of ErrorFunc by standard rules whose
+	// message depends on the settings of the validator.
+	errorFuncCtx func(ctx context.Context, field string, value interface{}, tag Tag) string
+
+	// settingsCtx is set by standard rules whose CheckerCtx only reads the
+	// settings of the validator from the context, these are run by Check.
+	settingsCtx bool
 }
 
 // RuleChecker is a function that receives the value of a
@@ -91,22 +249,130 @@ type ValidationRule struct {
 // Returns true when validation passed, or false if it didn't.
 type RuleChecker func(v interface{}, param string) bool
 
+// RuleCheckerCtx is a RuleChecker that receives the context passed
+// to StructCtx or FieldCtx, e.g. to look up request-scoped data.
+type RuleCheckerCtx func(ctx context.Context, v interface{}, param string) bool
+
+// RuleCheckerStruct is a RuleChecker that receives the struct containing
+// the field being validated. The parent is nil when validating a value
+// that is not a struct field, e.g. using Field.
+type RuleCheckerStruct func(parent interface{}, v interface{}, param string) bool
+
+// visit identifies a struct being validated by its address and type; a
+// struct and its first field share the same address. The parent is the
+// visit of the nearest enclosing struct reached through a pointer, used
+// to detect cycles.
+type visit struct {
+	addr   uintptr
+	typ    reflect.Type
+	parent *visit
+}
+
+// visiting returns true if the struct at addr of type typ is v or one of
+// its parents.
+func (v *visit) visiting(addr uintptr, typ reflect.Type) bool {
+	for ; v != nil; v = v.parent {
+		if v.addr == addr && v.typ == typ {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parentKey is the context key of the reflect.Value of the struct
+// containing the field being validated. The value is only converted to an
+// interface when a CheckerStruct needs it, which copies the struct.
+type parentKey struct{}
+
+// check runs CheckerStruct or CheckerCtx if set, otherwise Checker.
+// Panics if the kind of v is not one of the SupportedKinds.
+func (r ValidationRule) check(ctx context.Context, v interface{}, param string) bool {
+	if len(r.SupportedKinds) > 0 && !r.supports(v) {
+		panic(fmt.Sprintf("invalid type for %s tag: %s", r.Tag, kindName(v)))
+	}
+
+	if r.CheckerStruct != nil {
+		var parent interface{}
+		if sv, ok := ctx.Value(parentKey{}).(reflect.Value); ok {
+			parent = sv.Interface()
+		}
+
+		return r.CheckerStruct(parent, v, param)
+	}
+
+	if r.CheckerCtx != nil {
+		return r.CheckerCtx(ctx, v, param)
+	}
+
+	return r.Checker(v, param)
+}
+
+// supports returns true if the kind of v is one of the SupportedKinds.
+func (r ValidationRule) supports(v interface{}) bool {
+	return r.supportsKind(reflect.ValueOf(v).Kind())
+}
+
+// supportsKind returns true if kind is one of the SupportedKinds.
+func (r ValidationRule) supportsKind(kind reflect.Kind) bool {
+	for _, k := range r.SupportedKinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// kindName returns the kind of v for use in error messages, "nil" if v
+// is nil.
+func kindName(v interface{}) string {
+	if v == nil {
+		return "nil"
+	}
+
+	return reflect.ValueOf(v).Kind().String()
+}
+
 // RuleErrorFunc returns an error message. This function is
 // called when RuleChecker returned false.
 type RuleErrorFunc func(field string, value interface{}, tag Tag) string
 
 // Validator is the main validation construct.
 type Validator struct {
-	tagName       string
-	rules         map[string]ValidationRule
-	fullErrorPath bool
-	tagAliases    map[string][]Tag
+	tagName        string
+	msgTagName     string
+	msgResolver    MessageResolver
+	rules          map[string]ValidationRule
+	fullErrorPath  bool
+	trimStrings    bool
+	graphemes      bool
+	allFieldErrors bool
+	defaultErrFunc RuleErrorFunc
+	tagAliases     map[string][]Tag
+
+	// settings configure the standard rules, these are passed to the
+	// rules in the context. Nil uses the defaults.
+	settings *ruleSettings
+
+	// structRules is set once a rule with a CheckerStruct is added, the
+	// struct containing a field is only passed to the rules if set.
+	structRules bool
+
+	// tagCache holds the parsed tags by tag value, it is reset whenever
+	// a rule or alias changes.
+	tagCache *sync.Map
 }
 
 var DefaultValidator = NewValidator(
 	WithStandardRules(),
 	WithStandardAliases())
 
+// MessageResolver returns the error message of a failed rule, e.g. to
+// translate the message. The defaultMsg is the message returned by the
+// rule's ErrorFunc.
+type MessageResolver func(field string, tag Tag, defaultMsg string) string
+
 // Option allows functional options.
 type Option func(*Validator)
 
@@ -118,15 +384,143 @@ func WithFullErrorPath() func(*Validator) {
 	}
 }
 
+// fullErrorPathKey is the context key enabling full error paths for a
+// single validation, see FullErrorPathContext.
+type fullErrorPathKey struct{}
+
+// FullErrorPathContext returns a copy of ctx that makes StructCtx add the
+// entire path to the field error like WithFullErrorPath, without
+// configuring the validator itself.
+func FullErrorPathContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, fullErrorPathKey{}, true)
+}
+
+// WithTrimStrings trims leading and trailing whitespace of strings before
+// measuring their length in the "gte", "lte" and "len" rules. Other rules
+// are unaffected and the value itself is never modified.
+func WithTrimStrings() func(*Validator) {
+	return func(v *Validator) {
+		v.trimStrings = true
+	}
+}
+
+// WithGraphemeLength measures the length of strings in the "gte", "lte"
+// and "len" rules in grapheme clusters (user-perceived characters) instead
+// of runes, e.g. "👨‍👩‍👧‍👦" has a length of 1 instead of 7. This is
+// considerably slower than counting runes.
+func WithGraphemeLength() func(*Validator) {
+	return func(v *Validator) {
+		v.graphemes = true
+	}
+}
+
+// WithAllFieldErrors continues validating the remaining rules of a field
+// after a rule failed, so that all failing rules of a field are reported.
+// A field with more than one failing rule returns FieldErrors instead of
+// a FieldError. Rules with StopOnSuccess or StopOnFailure still stop the
+// validation of a field.
+func WithAllFieldErrors() func(*Validator) {
+	return func(v *Validator) {
+		v.allFieldErrors = true
+	}
+}
+
+// WithMessageTagName sets the name of the struct tag used to override
+// error messages, defaults to "msg".
+func WithMessageTagName(name string) func(*Validator) {
+	return func(v *Validator) {
+		v.msgTagName = name
+	}
+}
+
+// WithMessageResolver sets a MessageResolver to produce the description
+// of field errors. Messages set with a message struct tag take precedence
+// and are not passed to the resolver.
+func WithMessageResolver(resolver MessageResolver) func(*Validator) {
+	return func(v *Validator) {
+		v.msgResolver = resolver
+	}
+}
+
 // WithStandardRules adds the packaged validation rules.
 func WithStandardRules() func(*Validator) {
+	return WithStandardRulesExcept()
+}
+
+// WithStandardRulesExcept adds the packaged validation rules except the
+// rules with the given tags, e.g. WithStandardRulesExcept("resourcename",
+// "resourcepattern"). Using an excluded tag panics as an unknown tag.
+func WithStandardRulesExcept(tags ...string) func(*Validator) {
 	return func(v *Validator) {
+		excluded := make(map[string]bool, len(tags))
+		for _, tag := range tags {
+			excluded[tag] = true
+		}
+
 		for _, rule := range StandardRules {
-			v.AddRule(rule)
+			if !excluded[rule.Tag] {
+				v.AddRule(rule)
+			}
 		}
 	}
 }
 
+// WithGenderValues replaces the values accepted by the standard "gender"
+// rule, including its use in aliases.
+func WithGenderValues(values ...string) func(*Validator) {
+	return func(v *Validator) {
+		v.updateSettings(func(s *ruleSettings) {
+			s.genders = values
+		})
+	}
+}
+
+// WithResourcePrefix replaces the "mtx:" prefix of the standard
+// "resourcename" and "resourcepattern" rules, including their use in
+// aliases.
+func WithResourcePrefix(prefix string) func(*Validator) {
+	return func(v *Validator) {
+		v.updateSettings(func(s *ruleSettings) {
+			s.resources = newResourceMatcher(prefix)
+		})
+	}
+}
+
+// WithDateLayout sets the Go time layout of date strings in the standard
+// "isodate", "mindate", "maxdate" and "minage" rules, including the dates
+// specified as parameter, e.g. "02-01-2006". Defaults to YYYY-MM-DD. The
+// layout specified as parameter of "isodate" takes precedence.
+func WithDateLayout(layout string) func(*Validator) {
+	return func(v *Validator) {
+		v.updateSettings(func(s *ruleSettings) {
+			s.layout = layout
+		})
+	}
+}
+
+// WithClockLocation resolves "now" in the standard "mindate" and "maxdate"
+// rules to today's date in loc instead of UTC, including their use in
+// aliases such as "birthdate". The "minage" rule determines today's date
+// in loc as well.
+func WithClockLocation(loc *time.Location) func(*Validator) {
+	return func(v *Validator) {
+		v.updateSettings(func(s *ruleSettings) {
+			s.clock.Location = loc
+		})
+	}
+}
+
+// WithNowFunc replaces time.Now as the source of the current time of the
+// standard "mindate", "maxdate", "minage", "after" and "before" rules,
+// including their use in aliases, e.g. to freeze the time in a test.
+func WithNowFunc(now func() time.Time) func(*Validator) {
+	return func(v *Validator) {
+		v.updateSettings(func(s *ruleSettings) {
+			s.clock.Now = now
+		})
+	}
+}
+
 // WithStandardRules adds the packaged tag aliases.
 func WithStandardAliases() func(*Validator) {
 	return func(v *Validator) {
@@ -140,9 +534,12 @@ func WithStandardAliases() func(*Validator) {
 // validation rules.
 func NewValidator(options ...Option) *Validator {
 	val := &Validator{
-		tagName:    "validate",
-		rules:      map[string]ValidationRule{},
-		tagAliases: make(map[string][]Tag),
+		tagName:        "validate",
+		msgTagName:     "msg",
+		rules:          map[string]ValidationRule{},
+		tagAliases:     make(map[string][]Tag),
+		tagCache:       &sync.Map{},
+		defaultErrFunc: DefaultErr,
 	}
 	for _, option := range options {
 		option(val)
@@ -151,17 +548,140 @@ func NewValidator(options ...Option) *Validator {
 	return val
 }
 
+// Clone returns a copy of the validator with its own rules and aliases,
+// so that rules and aliases can be added to the copy without affecting
+// the original. The rule functions themselves are shared; these are
+// expected to be stateless.
+func (mv *Validator) Clone() *Validator {
+	clone := *mv
+	clone.rules = make(map[string]ValidationRule, len(mv.rules))
+	clone.tagAliases = make(map[string][]Tag, len(mv.tagAliases))
+	clone.tagCache = &sync.Map{}
+
+	for tag, rule := range mv.rules {
+		clone.rules[tag] = rule
+	}
+
+	for alias, tags := range mv.tagAliases {
+		clone.tagAliases[alias] = append([]Tag(nil), tags...)
+	}
+
+	return &clone
+}
+
+// updateSettings changes a copy of the rule settings, settings may be
+// shared with clones of the validator.
+func (mv *Validator) updateSettings(update func(s *ruleSettings)) {
+	s := ruleSettings{}
+	if mv.settings != nil {
+		s = *mv.settings
+	}
+
+	update(&s)
+	mv.settings = &s
+}
+
+// SetDefaultMessage replaces the error function used for a failing rule
+// that has no ErrorFunc and is not StopOnFailure, e.g. a custom rule that
+// lacks an ErrorFunc by mistake. Defaults to DefaultErr. Set nil to stop
+// validation of the field without an error instead, like StopOnFailure.
+func (mv *Validator) SetDefaultMessage(errFunc RuleErrorFunc) {
+	mv.defaultErrFunc = errFunc
+}
+
+// stopsOnFailure returns true if a failing rule stops validation without
+// an error.
+func (mv *Validator) stopsOnFailure(rule ValidationRule) bool {
+	return rule.StopOnFailure || (rule.ErrorFunc == nil && mv.defaultErrFunc == nil)
+}
+
+// DefaultErr is the default error function of rules without ErrorFunc.
+func DefaultErr(field string, _ interface{}, _ Tag) string {
+	return fmt.Sprintf("%s is invalid", field)
+}
+
 // AddRule adds a new rule or overwrites and existing rule
 // if a rule with the same tag already exists.
 func (mv *Validator) AddRule(rule ValidationRule) {
 	mv.rules[rule.Tag] = rule
+	mv.structRules = mv.structRules || rule.CheckerStruct != nil
+	mv.tagCache = &sync.Map{}
+}
+
+// RemoveRule removes the rule with the given tag. Using the tag afterwards
+// panics as if the rule was never added. Aliases added before the rule was
+// removed keep using it.
+func (mv *Validator) RemoveRule(tag string) {
+	delete(mv.rules, tag)
+	mv.tagCache = &sync.Map{}
+}
+
+// RemoveAlias removes the alias with the given name. Using the alias
+// afterwards panics as if it was never added.
+func (mv *Validator) RemoveAlias(alias string) {
+	delete(mv.tagAliases, alias)
+	mv.tagCache = &sync.Map{}
+}
+
+// Rules returns the sorted tags of the registered rules, e.g. to generate
+// documentation. Aliases are not included, see Aliases.
+func (mv *Validator) Rules() []string {
+	tags := make([]string, 0, len(mv.rules))
+	for tag := range mv.rules {
+		tags = append(tags, tag)
+	}
+
+	sort.Strings(tags)
+
+	return tags
+}
+
+// Aliases returns the registered aliases and the tags they expand to, e.g.
+// "username": "aZ09_,gte=4,lte=20". Aliases used within an alias are
+// returned expanded into their rules.
+func (mv *Validator) Aliases() map[string]string {
+	aliases := make(map[string]string, len(mv.tagAliases))
+
+	for alias, tags := range mv.tagAliases {
+		parts := make([]string, 0, len(tags))
+
+		for _, tag := range tags {
+			if tag.Param == "" {
+				parts = append(parts, tag.Name)
+			} else {
+				parts = append(parts, tag.Name+"="+strings.ReplaceAll(tag.Param, ",", `\,`))
+			}
+		}
+
+		aliases[alias] = strings.Join(parts, ",")
+	}
+
+	return aliases
 }
 
 // AddAlias adds a new alias or overwrites an existing one
 // if alias already exists. Panics if one of the tags
 // does not exist.
 func (mv *Validator) AddAlias(alias string, tags string) {
+	if err := mv.RegisterAlias(alias, tags); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterAlias adds a new alias or overwrites an existing one
+// if alias already exists. Returns an error if one of the tags
+// does not refer to a known rule or alias.
+func (mv *Validator) RegisterAlias(alias string, tags string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("registering %s alias %q: %w: %v", mv.tagName, alias, ErrUnsupported, r)
+		}
+	}()
+
 	mv.tagAliases[alias] = mv.mustParseTags(tags)
+	mv.tagCache = &sync.Map{}
+
+	return nil
 }
 
 // Struct validates the fields of a struct based on
@@ -179,7 +699,19 @@ func Struct(value interface{}) error {
 //
 // Panics if given value is not a struct.
 func (mv *Validator) Struct(value interface{}) error {
-	errs := mv.validateStruct(value, "")
+	return mv.StructCtx(context.Background(), value)
+}
+
+// StructCtx validates the fields of a struct like Struct and passes
+// ctx to context-aware rules.
+func StructCtx(ctx context.Context, value interface{}) error {
+	return DefaultValidator.StructCtx(ctx, value)
+}
+
+// StructCtx validates the fields of a struct like Struct and passes
+// ctx to context-aware rules.
+func (mv *Validator) StructCtx(ctx context.Context, value interface{}) error {
+	errs := mv.validateStruct(mv.ruleContext(ctx), nil, reflect.ValueOf(value), "")
 	if len(errs) > 0 {
 		return errs
 	}
@@ -187,31 +719,160 @@ func (mv *Validator) Struct(value interface{}) error {
 	return nil
 }
 
-// Struct validates the fields of a struct based on
+// Structs validates every struct in a slice or array like Struct and
+// returns the errors of all elements combined. Fields are prefixed with
+// the index of the element, e.g. "[2].Name". Panics if values is not
+// a slice or array of structs.
+func Structs(values interface{}) error {
+	return DefaultValidator.Structs(values)
+}
+
+// Structs validates every struct in a slice or array like Struct and
+// returns the errors of all elements combined. Fields are prefixed with
+// the index of the element, e.g. "[2].Name". Panics if values is not
+// a slice or array of structs.
+func (mv *Validator) Structs(values interface{}) error {
+	sv := reflect.ValueOf(values)
+	if sv.Kind() != reflect.Slice && sv.Kind() != reflect.Array {
+		panic(fmt.Sprintf("validate.Structs requires a slice or array, got %s", sv.Kind()))
+	}
+
+	// the kind of interface elements is only known per element
+	elem := sv.Type().Elem()
+	for elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+
+	if elem.Kind() != reflect.Struct && elem.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("validate.Structs requires a slice or array of structs, got %s", sv.Type()))
+	}
+
+	ctx := mv.ruleContext(context.Background())
+
+	var result FieldErrors
+
+	for i := 0; i < sv.Len(); i++ {
+		if elem.Kind() == reflect.Interface {
+			ev := sv.Index(i)
+			for (ev.Kind() == reflect.Ptr || ev.Kind() == reflect.Interface) && !ev.IsNil() {
+				ev = ev.Elem()
+			}
+
+			if ev.Kind() != reflect.Struct && ev.Kind() != reflect.Ptr && ev.Kind() != reflect.Interface {
+				panic(fmt.Sprintf("validate.Structs requires a slice or array of structs, got %s at index %d", ev.Type(), i))
+			}
+		}
+
+		prefix := "[" + strconv.Itoa(i) + "]."
+		for _, err := range mv.validateStruct(ctx, nil, sv.Index(i), "") {
+			err.Field = prefix + err.Field
+			result = append(result, err)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+// Map validates the structs, and collections of structs, held by the
+// values of a map, e.g. a decoded map[string]interface{} payload. Fields
+// are prefixed with the key of the value, e.g. "[user](value).Name".
+// Panics if m is not a map.
+func Map(m interface{}) error {
+	return DefaultValidator.Map(m)
+}
+
+// Map validates the structs, and collections of structs, held by the
+// values of a map, e.g. a decoded map[string]interface{} payload. Fields
+// are prefixed with the key of the value, e.g. "[user](value).Name".
+// Panics if m is not a map.
+func (mv *Validator) Map(m interface{}) error {
+	value := reflect.ValueOf(m)
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Map {
+		panic(fmt.Sprintf("validate.Map requires a map, got %s", value.Kind()))
+	}
+
+	ctx := mv.ruleContext(context.Background())
+
+	var result FieldErrors
+
+	for _, key := range sortedMapKeys(value) {
+		prefix := fmt.Sprintf("[%+v](value).", key.Interface())
+		for _, err := range mv.deepValidateTaglessField(ctx, nil, value.MapIndex(key), "") {
+			err.Field = prefix + err.Field
+			result = append(result, err)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+// StructSafe behaves like Struct but returns an error wrapping ErrUnsupported
+// instead of panicking when an unknown tag or unsupported type is encountered.
+func StructSafe(value interface{}) error {
+	return DefaultValidator.StructSafe(value)
+}
+
+// StructSafe behaves like Struct but returns an error wrapping ErrUnsupported
+// instead of panicking when an unknown tag or unsupported type is encountered.
+func (mv *Validator) StructSafe(value interface{}) (err error) {
+	defer recoverUnsupported(&err)
+
+	return mv.Struct(value)
+}
+
+// validateStruct validates the fields of a struct based on
 // the validator's tag and returns an array FieldErrors if
 // one or more errors were found. Returns nil if no errors
-// were found.
+// were found. Pointers and interfaces are followed to the
+// underlying struct without copying it.
+//
+// A struct that is being validated already, because it is reachable from
+// itself through pointers, is skipped so that cyclic structures such as a
+// tree whose nodes point to their parent terminate. The parent is the
+// visit of the nearest enclosing struct reached through a pointer, if any.
 //
 // Panics if given value is not a struct.
-func (mv *Validator) validateStruct(value interface{}, fieldName string) (errs FieldErrors) {
-	sv := reflect.ValueOf(value)
-	st := reflect.TypeOf(value)
+func (mv *Validator) validateStruct(ctx context.Context, parent *visit, sv reflect.Value, fieldName string) (errs FieldErrors) {
+	pointer := false
 
-	if sv.Kind() == reflect.Ptr {
+	for sv.Kind() == reflect.Ptr || sv.Kind() == reflect.Interface {
 		if sv.IsNil() {
 			return nil
 		}
 
-		errs = mv.validateStruct(sv.Elem().Interface(), fieldName)
-	} else {
-		errs = mv.validateStructFields(st, sv)
+		pointer = pointer || sv.Kind() == reflect.Ptr
+		sv = sv.Elem()
 	}
 
+	// only structs reached through a pointer can be part of a cycle
+	if pointer {
+		addr, typ := sv.UnsafeAddr(), sv.Type()
+		if parent.visiting(addr, typ) {
+			return nil
+		}
+
+		parent = &visit{addr: addr, typ: typ, parent: parent}
+	}
+
+	errs = mv.validateStructFields(ctx, parent, sv.Type(), sv)
+
 	if len(errs) == 0 {
 		return nil
 	}
 
-	if !mv.fullErrorPath || fieldName == "" {
+	if fieldName == "" || (!mv.fullErrorPath && ctx.Value(fullErrorPathKey{}) == nil) {
 		return errs
 	}
 
@@ -219,50 +880,59 @@ func (mv *Validator) validateStruct(value interface{}, fieldName string) (errs F
 
 	// Prefix field name to returned error details, e.g. "user.firstname" instead of just "firstname"
 	for _, err := range errs {
-		result = append(result, FieldError{
-			Field:       fieldName + "." + err.Field,
-			Description: err.Description,
-		})
+		err.Field = fieldName + "." + err.Field
+		result = append(result, err)
 	}
 
 	return result
 }
 
-func (mv *Validator) validateStructFields(st reflect.Type, sv reflect.Value) (result FieldErrors) {
+func (mv *Validator) validateStructFields(ctx context.Context, parent *visit, st reflect.Type, sv reflect.Value) (result FieldErrors) {
+	if mv.structRules && sv.CanInterface() {
+		ctx = context.WithValue(ctx, parentKey{}, sv)
+	}
+
 	fieldCount := sv.NumField()
 	for i := 0; i < fieldCount; i++ {
-		field := st.Field(i).Name
+		sf := st.Field(i)
+		field := sf.Name
 
 		// only public fields are validatable
 		if !unicode.IsUpper(rune(field[0])) {
 			continue
 		}
 
-		f := sv.Field(i)
+		fv := sv.Field(i)
 
 		// deal with pointers
+		f := fv
 		for f.Kind() == reflect.Ptr && !f.IsNil() {
 			f = f.Elem()
 		}
 
 		// fetch tag to validate
-		tag := st.Field(i).Tag.Get(mv.tagName)
+		tag := sf.Tag.Get(mv.tagName)
 		if tag == "-" {
 			continue
 		}
 
 		if tag != "" {
-			// tags are only defined on validatable fields
-			if err := mv.Field(f.Interface(), st.Field(i).Name, tag); err != nil {
-				var fieldError FieldError
-
-				errors.As(err, &fieldError)
-				result = append(result, fieldError)
+			// tags are only defined on validatable fields, the field
+			// dereferences pointers itself
+			if err := mv.field(ctx, fv.Interface(), field, tag, sf.Tag); err != nil {
+				result = appendFieldErrors(result, err)
 			}
 		}
 
+		// fields of embedded structs are promoted to the parent struct and
+		// therefore not prefixed with the embedded type name
+		path := field
+		if sf.Anonymous && f.Kind() == reflect.Struct {
+			path = ""
+		}
+
 		// validate struct, interface, array, slice or map that have no tag
-		errs := mv.deepValidateTaglessField(f, field)
+		errs := mv.deepValidateTaglessField(ctx, parent, fv, path)
 		if errs != nil {
 			result = append(result, errs...)
 		}
@@ -272,7 +942,7 @@ func (mv *Validator) validateStructFields(st reflect.Type, sv reflect.Value) (re
 }
 
 // deepValidateTaglessField validates a struct, interface, array, slice or map that have no tag.
-func (mv *Validator) deepValidateTaglessField(value reflect.Value, field string) FieldErrors {
+func (mv *Validator) deepValidateTaglessField(ctx context.Context, parent *visit, value reflect.Value, field string) FieldErrors {
 	switch value.Kind() {
 	case reflect.Interface, reflect.Ptr:
 		if value.IsNil() {
@@ -280,22 +950,36 @@ func (mv *Validator) deepValidateTaglessField(value reflect.Value, field string)
 			return nil
 		}
 
-		fallthrough
+		// structs reached through a pointer are checked for cycles
+		if value.Kind() == reflect.Ptr && value.Elem().Kind() == reflect.Struct {
+			return mv.validateStruct(ctx, parent, value, field)
+		}
+
+		// descend into whatever the interface or pointer holds, e.g. an
+		// interface holding a pointer to a struct or a slice
+		return mv.deepValidateTaglessField(ctx, parent, value.Elem(), field)
 	case reflect.Struct:
-		return mv.validateStruct(value.Interface(), field)
+		return mv.validateStruct(ctx, parent, value, field)
 	case reflect.Array, reflect.Slice:
-		return mv.validateCollection(value, field)
+		return mv.validateCollection(ctx, parent, value, field)
 	case reflect.Map:
-		return mv.validateMap(value, field)
+		return mv.validateMap(ctx, parent, value, field)
 	default:
 	}
 
 	return nil
 }
 
-func (mv *Validator) validateCollection(value reflect.Value, field string) (result FieldErrors) {
+func (mv *Validator) validateCollection(ctx context.Context, parent *visit, value reflect.Value, field string) (result FieldErrors) {
+	// elements of other types have no fields to validate
+	switch value.Type().Elem().Kind() {
+	case reflect.Struct, reflect.Ptr, reflect.Interface, reflect.Array, reflect.Slice, reflect.Map:
+	default:
+		return nil
+	}
+
 	for i := 0; i < value.Len(); i++ {
-		if errs := mv.deepValidateTaglessField(value.Index(i), field+"["+string(rune(i))+"]"); errs != nil {
+		if errs := mv.deepValidateTaglessField(ctx, parent, value.Index(i), field+"["+strconv.Itoa(i)+"]"); errs != nil {
 			if result == nil {
 				result = FieldErrors{}
 			}
@@ -311,10 +995,10 @@ func (mv *Validator) validateCollection(value reflect.Value, field string) (resu
 	return result
 }
 
-func (mv *Validator) validateMap(value reflect.Value, field string) (result FieldErrors) {
-	for _, key := range value.MapKeys() {
+func (mv *Validator) validateMap(ctx context.Context, parent *visit, value reflect.Value, field string) (result FieldErrors) {
+	for _, key := range sortedMapKeys(value) {
 		// validate the map key
-		errs := mv.deepValidateTaglessField(key, fmt.Sprintf("%s[%+v](key)", field, key.Interface()))
+		errs := mv.deepValidateTaglessField(ctx, parent, key, fmt.Sprintf("%s[%+v](key)", field, key.Interface()))
 		if errs != nil {
 			if result == nil {
 				result = FieldErrors{}
@@ -326,7 +1010,7 @@ func (mv *Validator) validateMap(value reflect.Value, field string) (result Fiel
 		// validate the map value
 		value := value.MapIndex(key)
 
-		errs = mv.deepValidateTaglessField(value, fmt.Sprintf("%s[%+v](value)", field, key.Interface()))
+		errs = mv.deepValidateTaglessField(ctx, parent, value, fmt.Sprintf("%s[%+v](value)", field, key.Interface()))
 		if errs != nil {
 			if result == nil {
 				result = FieldErrors{}
@@ -343,55 +1027,433 @@ func (mv *Validator) validateMap(value reflect.Value, field string) (result Fiel
 	return result
 }
 
+// sortedMapKeys returns the keys of a map sorted to return errors in a
+// consistent order.
+func sortedMapKeys(value reflect.Value) []reflect.Value {
+	keys := value.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprintf("%+v", keys[i].Interface()) < fmt.Sprintf("%+v", keys[j].Interface())
+	})
+
+	return keys
+}
+
+// appendFieldErrors appends err to errs if err is a FieldError or FieldErrors.
+func appendFieldErrors(errs FieldErrors, err error) FieldErrors {
+	var fieldErrors FieldErrors
+
+	var fieldError FieldError
+
+	switch {
+	case errors.As(err, &fieldErrors):
+		return append(errs, fieldErrors...)
+	case errors.As(err, &fieldError):
+		return append(errs, fieldError)
+	default:
+		return errs
+	}
+}
+
 // Field validates a value based on the provided tags. Returns the
-// first error found or nil when valid.
+// first error found or nil when valid. When the tags "dive" into a map
+// the errors of all keys and values are returned as FieldErrors.
 func Field(val interface{}, field string, tags string) error {
 	return DefaultValidator.Field(val, field, tags)
 }
 
 // Field validates a value based on the provided tags. Returns the
-// first error found or nil when valid.
+// first error found or nil when valid. When the tags "dive" into a map
+// the errors of all keys and values are returned as FieldErrors.
 func (mv *Validator) Field(val interface{}, field string, tags string) error {
+	return mv.FieldCtx(context.Background(), val, field, tags)
+}
+
+// FieldTags validates a value like Field with each tag passed as a
+// separate argument, e.g. FieldTags(val, "Name", "required", "gte=3").
+// Commas within a tag are part of its param, e.g. "duration=1s,1h".
+func FieldTags(val interface{}, field string, tags ...string) error {
+	return DefaultValidator.FieldTags(val, field, tags...)
+}
+
+// FieldTags validates a value like Field with each tag passed as a
+// separate argument, e.g. FieldTags(val, "Name", "required", "gte=3").
+// Commas within a tag are part of its param, e.g. "duration=1s,1h".
+func (mv *Validator) FieldTags(val interface{}, field string, tags ...string) error {
+	escaped := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		escaped = append(escaped, strings.Join(splitUnescapedComma(tag), `\,`))
+	}
+
+	return mv.Field(val, field, strings.Join(escaped, ","))
+}
+
+// FieldCtx validates a value like Field and passes ctx to
+// context-aware rules.
+func FieldCtx(ctx context.Context, val interface{}, field string, tags string) error {
+	return DefaultValidator.FieldCtx(ctx, val, field, tags)
+}
+
+// FieldCtx validates a value like Field and passes ctx to
+// context-aware rules.
+func (mv *Validator) FieldCtx(ctx context.Context, val interface{}, field string, tags string) error {
+	return mv.field(mv.ruleContext(ctx), val, field, tags, "")
+}
+
+// Var validates a standalone value based on the provided tags. Unlike
+// Field no field name is required; the returned FieldError has an empty
+// Field and its description refers to the value as "Value", e.g.
+// "Value is required". Its Error() returns "value is invalid". Returns
+// nil when valid.
+func Var(val interface{}, tags string) error {
+	return DefaultValidator.Var(val, tags)
+}
+
+// Var validates a standalone value based on the provided tags. Unlike
+// Field no field name is required; the returned FieldError has an empty
+// Field and its description refers to the value as "Value", e.g.
+// "Value is required". Its Error() returns "value is invalid". Returns
+// nil when valid.
+func (mv *Validator) Var(val interface{}, tags string) error {
+	err := mv.Field(val, varField, tags)
+
+	var fieldErrors FieldErrors
+
+	var fieldError FieldError
+
+	switch {
+	case errors.As(err, &fieldErrors):
+		// errors of map keys and values keep their path, e.g. "[key](value)"
+		for i := range fieldErrors {
+			fieldErrors[i].Field = strings.TrimPrefix(fieldErrors[i].Field, varField)
+		}
+
+		return fieldErrors
+	case errors.As(err, &fieldError):
+		fieldError.Field = ""
+
+		return fieldError
+	default:
+		return err
+	}
+}
+
+// field validates a value based on the provided tags. The struct tag
+// of the field, if any, may override the error message.
+func (mv *Validator) field(
+	ctx context.Context,
+	val interface{},
+	field string,
+	tags string,
+	structTag reflect.StructTag,
+) error {
 	if tags == "-" {
 		return nil
 	}
 
+	// rules validate the value a pointer points to, except presence rules
+	var pointer interface{}
+
 	v := reflect.ValueOf(val)
 	if v.Kind() == reflect.Ptr && !v.IsNil() {
-		return mv.Field(v.Elem().Interface(), field, tags)
+		pointer = val
+	}
+
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+		val = v.Interface()
 	}
 
 	var err error
 
 	switch v.Kind() {
 	case reflect.Invalid:
-		err = mv.singleField(nil, field, tags)
+		err = mv.singleField(ctx, nil, pointer, field, tags, structTag)
 	default:
-		err = mv.singleField(val, field, tags)
+		err = mv.singleField(ctx, val, pointer, field, tags, structTag)
 	}
 
 	return err
 }
 
-// singleField validates one single variable.
-func (mv *Validator) singleField(v interface{}, field string, tag string) error {
+// FieldSafe behaves like Field but returns an error wrapping ErrUnsupported
+// instead of panicking when an unknown tag or unsupported type is encountered.
+func FieldSafe(val interface{}, field string, tags string) error {
+	return DefaultValidator.FieldSafe(val, field, tags)
+}
+
+// FieldSafe behaves like Field but returns an error wrapping ErrUnsupported
+// instead of panicking when an unknown tag or unsupported type is encountered.
+func (mv *Validator) FieldSafe(val interface{}, field string, tags string) (err error) {
+	defer recoverUnsupported(&err)
+
+	return mv.Field(val, field, tags)
+}
+
+// RunRule runs a single rule against a value without parsing tags or
+// expanding aliases, e.g. to unit-test a custom rule. The tag may contain
+// a param, e.g. "gte=3". Returns whether the value is valid and, if not,
+// the error message of the rule. Returns an error wrapping ErrUnsupported
+// if the tag is unknown or the rule does not support the value.
+func (mv *Validator) RunRule(tag string, value interface{}, field string) (valid bool, msg string, err error) {
+	defer recoverUnsupported(&err)
+
+	t := Tag{}
+	pieces := strings.SplitN(tag, "=", 2) //nolint:gomnd
+	t.Name = strings.TrimSpace(pieces[0])
+
+	if len(pieces) > 1 {
+		t.Param = strings.TrimSpace(pieces[1])
+	}
+
+	var found bool
+	if t.Rule, found = mv.rules[t.Name]; !found {
+		return false, "", fmt.Errorf("%w: unknown %s tag %q", ErrUnsupported, mv.tagName, t.Name)
+	}
+
+	ctx := mv.ruleContext(context.Background())
+
+	val, textErr := textValue(t.Rule, value)
+	if textErr == nil && t.Rule.check(ctx, val, t.Param) {
+		return true, "", nil
+	}
+
+	if mv.stopsOnFailure(t.Rule) {
+		return false, "", nil
+	}
+
+	return false, mv.errorMessage(ctx, field, val, t, ""), nil
+}
+
+// recoverUnsupported converts a panic raised by a misconfigured tag
+// or rule into an error wrapping ErrUnsupported.
+func recoverUnsupported(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("%w: %v", ErrUnsupported, r)
+	}
+}
+
+// singleField validates one single variable. The pointer is the non-nil
+// pointer v was read from, if any.
+func (mv *Validator) singleField(
+	ctx context.Context,
+	v interface{},
+	pointer interface{},
+	field string,
+	tag string,
+	structTag reflect.StructTag,
+) error {
+	// A leading "omitempty" skips all other rules when the value is empty,
+	// a non-nil pointer is not empty
+	if rest, ok := trimOmitEmpty(tag); ok {
+		present := v
+		if pointer != nil {
+			present = pointer
+		}
+
+		if rest == "" || !Required(present, "") {
+			return nil
+		}
+
+		tag = rest
+	}
+
+	tag, keyTags, valueTags, dive := splitDive(tag)
+	if !dive || tag != "" {
+		if stop, err := mv.checkTags(ctx, v, pointer, field, tag, structTag); stop || err != nil {
+			return err
+		}
+	}
+
+	if dive {
+		return mv.diveMap(ctx, v, field, keyTags, valueTags)
+	}
+
+	return nil
+}
+
+// checkTags validates one single variable with all rules in tag. Returns
+// the error of the first rule that failed, or the errors of all failed
+// rules when WithAllFieldErrors is set. Returns true when a rule stopped
+// validation of the field.
+func (mv *Validator) checkTags(
+	ctx context.Context,
+	v interface{},
+	pointer interface{},
+	field string,
+	tag string,
+	structTag reflect.StructTag,
+) (bool, error) {
+	var errs FieldErrors
+
 	tags := mv.mustParseTags(tag)
 	for _, t := range tags {
-		if !t.Rule.Checker(v, t.Param) {
-			// The "optional" tag does not define an error function, it simply stops
-			// further validation.
-			if t.Rule.ErrorFunc == nil {
-				return nil
+		val, textErr := textValue(t.Rule, v)
+		if mv.trimStrings && lengthRules[t.Name] {
+			val = trimString(val)
+		}
+
+		if pointer != nil && t.Rule.Presence {
+			val = pointer
+		}
+
+		// a value that cannot be marshalled to text fails the rule
+		if textErr == nil && t.Rule.check(ctx, val, t.Param) {
+			if t.Rule.StopOnSuccess {
+				return true, fieldErrorsOrNil(errs)
 			}
 
-			return FieldError{
-				Field:       field,
-				Description: t.Rule.ErrorFunc(field, v, t),
+			continue
+		}
+
+		if mv.stopsOnFailure(t.Rule) {
+			return true, fieldErrorsOrNil(errs)
+		}
+
+		err := FieldError{
+			Field:       field,
+			Description: mv.errorMessage(ctx, field, val, t, structTag),
+			Tag:         t.Name,
+			Param:       t.Param,
+		}
+
+		if !mv.allFieldErrors {
+			return true, err
+		}
+
+		errs = append(errs, err)
+	}
+
+	return len(errs) > 0, fieldErrorsOrNil(errs)
+}
+
+// ruleContext returns the context passed to rules, holding the
+// configuration of the validator rules depend on. It is created once per
+// validation.
+func (mv *Validator) ruleContext(ctx context.Context) context.Context {
+	if mv.graphemes {
+		ctx = context.WithValue(ctx, lengthFuncKey{}, uniseg.GraphemeClusterCount)
+	}
+
+	if mv.settings != nil {
+		ctx = context.WithValue(ctx, ruleSettingsKey{}, mv.settings)
+	}
+
+	return ctx
+}
+
+// fieldErrorsOrNil returns nil if errs is empty, the single FieldError if
+// errs contains one error and errs otherwise.
+func fieldErrorsOrNil(errs FieldErrors) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return errs
+	}
+}
+
+// diveMap validates every key of a map with keyTags and every value with
+// valueTags. Errors are reported as "field[key](key)" and
+// "field[key](value)" respectively.
+func (mv *Validator) diveMap(ctx context.Context, v interface{}, field string, keyTags, valueTags string) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Map {
+		panic("invalid type for dive tag")
+	}
+
+	var result FieldErrors
+
+	for _, key := range sortedMapKeys(value) {
+		if keyTags != "" {
+			path := fmt.Sprintf("%s[%+v](key)", field, key.Interface())
+			if err := mv.field(ctx, key.Interface(), path, keyTags, ""); err != nil {
+				result = appendFieldErrors(result, err)
+			}
+		}
+
+		if valueTags != "" {
+			path := fmt.Sprintf("%s[%+v](value)", field, key.Interface())
+			if err := mv.field(ctx, value.MapIndex(key).Interface(), path, valueTags, ""); err != nil {
+				result = appendFieldErrors(result, err)
 			}
 		}
 	}
 
-	return nil
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
+
+// textValue returns the text form of v as a string if v implements
+// encoding.TextMarshaler and rule supports strings but not the kind of v,
+// otherwise v is returned as-is. A nil pointer has an empty text form.
+func textValue(rule ValidationRule, v interface{}) (interface{}, error) {
+	m, ok := v.(encoding.TextMarshaler)
+	if !ok || len(rule.SupportedKinds) == 0 || rule.supports(v) || !rule.supportsKind(reflect.String) {
+		return v, nil
+	}
+
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return "", nil
+	}
+
+	text, err := m.MarshalText()
+	if err != nil {
+		return v, fmt.Errorf("marshalling %T to text: %w", v, err)
+	}
+
+	return string(text), nil
+}
+
+// trimString returns v with leading and trailing whitespace removed
+// if v is a string, otherwise v is returned as-is.
+func trimString(v interface{}) interface{} {
+	st := reflect.ValueOf(v)
+	if st.Kind() != reflect.String {
+		return v
+	}
+
+	return strings.TrimSpace(st.String())
+}
+
+// errorMessage returns the message of the rule-specific message tag
+// (e.g. `msg_required:"..."`) or the generic message tag (`msg:"..."`)
+// if present on the struct field. Otherwise the rule's ErrorFunc is used,
+// passed through the MessageResolver if one was configured.
+func (mv *Validator) errorMessage(
+	ctx context.Context,
+	field string,
+	v interface{},
+	t Tag,
+	structTag reflect.StructTag,
+) string {
+	if msg, ok := structTag.Lookup(mv.msgTagName + "_" + t.Name); ok {
+		return msg
+	}
+
+	if msg, ok := structTag.Lookup(mv.msgTagName); ok {
+		return msg
+	}
+
+	var msg string
+
+	switch {
+	case t.Rule.errorFuncCtx != nil:
+		msg = t.Rule.errorFuncCtx(ctx, field, v, t)
+	case t.Rule.ErrorFunc != nil:
+		msg = t.Rule.ErrorFunc(field, v, t)
+	default:
+		msg = mv.defaultErrFunc(field, v, t)
+	}
+	if mv.msgResolver != nil {
+		return mv.msgResolver(field, t, msg)
+	}
+
+	return msg
 }
 
 // Fields is a helper method to wrap a set of validate.Field() and returns
@@ -408,6 +1470,13 @@ func Fields(errs ...error) error {
 				result = make([]FieldError, 0)
 			}
 
+			var fieldErrors FieldErrors
+			if errors.As(err, &fieldErrors) {
+				result = append(result, fieldErrors...)
+
+				continue
+			}
+
 			var fieldError FieldError
 
 			errors.As(err, &fieldError)
@@ -428,10 +1497,28 @@ type Tag struct {
 	Param string
 }
 
+// ParseTags returns the individual tags found within a tag value with
+// aliases expanded. A leading "omitempty" keyword is not a rule and is
+// omitted from the result. Returns an error wrapping ErrUnsupported if
+// an unknown tag was found.
+func (mv *Validator) ParseTags(t string) (tags []Tag, err error) {
+	defer recoverUnsupported(&err)
+
+	if rest, ok := trimOmitEmpty(t); ok {
+		if rest == "" {
+			return []Tag{}, nil
+		}
+
+		t = rest
+	}
+
+	return mv.mustParseTags(t), nil
+}
+
 // mustParseTags parses all individual tags found within a tag value.
 // Caches the result. Panics if an unknown tag was found.
 func (mv *Validator) mustParseTags(t string) []Tag {
-	if val, ok := tagCache.Load(t); ok {
+	if val, ok := mv.tagCache.Load(t); ok {
 		return val.([]Tag)
 	}
 
@@ -464,11 +1551,68 @@ func (mv *Validator) mustParseTags(t string) []Tag {
 		}
 	}
 
-	tagCache.Store(t, tags)
+	mv.tagCache.Store(t, tags)
 
 	return tags
 }
 
+// trimOmitEmpty strips a leading "omitempty" keyword from a tag value.
+// Returns false if the tag value does not start with "omitempty".
+func trimOmitEmpty(t string) (string, bool) {
+	if !strings.HasPrefix(strings.TrimLeft(t, " "), omitEmptyKeyword) {
+		return t, false
+	}
+
+	pieces := splitUnescapedComma(t)
+	if strings.Trim(pieces[0], " ") != omitEmptyKeyword {
+		return t, false
+	}
+
+	return strings.Join(pieces[1:], ","), true
+}
+
+// splitDive splits a tag value at the "dive" keyword into the tags of the
+// value itself and the tags of the map keys and values. Key tags are
+// enclosed by "keys" and "endkeys" directly after "dive", e.g.
+// "required,dive,keys,slug,endkeys,url". Returns false if the tag value
+// does not contain "dive".
+func splitDive(t string) (tag, keyTags, valueTags string, ok bool) {
+	if !strings.Contains(t, diveKeyword) {
+		return t, "", "", false
+	}
+
+	pieces := splitUnescapedComma(t)
+	for i, piece := range pieces {
+		if strings.Trim(piece, " ") != diveKeyword {
+			continue
+		}
+
+		rest := pieces[i+1:]
+		if len(rest) > 0 && strings.Trim(rest[0], " ") == keysKeyword {
+			end := -1
+
+			for j, p := range rest {
+				if strings.Trim(p, " ") == endKeysKeyword {
+					end = j
+
+					break
+				}
+			}
+
+			if end == -1 {
+				panic(fmt.Sprintf("%q without %q", keysKeyword, endKeysKeyword))
+			}
+
+			keyTags = strings.Join(rest[1:end], ",")
+			rest = rest[end+1:]
+		}
+
+		return strings.Join(pieces[:i], ","), keyTags, strings.Join(rest, ","), true
+	}
+
+	return t, "", "", false
+}
+
 func splitUnescapedComma(str string) []string {
 	indexes := sepPattern.FindAllStringIndex(str, -1)
 	pieces := make([]string, 0)